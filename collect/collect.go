@@ -1,3 +1,7 @@
+// Package collect
+// @Description: generic helpers for slices and maps.
+// Helpers that return a slice never modify the given slice and never return
+// memory shared with it, so callers may keep using their input afterwards.
 package collect
 
 import (
@@ -269,15 +273,15 @@ func Sort[V constracts.SortInterFaceGenerics](subject []V) (response []V) {
 	item := subject[0]
 	switch TypeTransformOrFail[V, interface{}](item).(type) {
 	case int:
-		tmp := TypeTransformOrFail[[]V, []int](subject)
+		tmp := TypeTransformOrFail[[]V, []int](Clone(subject))
 		sort.Ints(tmp)
 		response = TypeTransformOrFail[[]int, []V](tmp)
 	case string:
-		tmp := TypeTransformOrFail[[]V, []string](subject)
+		tmp := TypeTransformOrFail[[]V, []string](Clone(subject))
 		sort.Strings(tmp)
 		response = TypeTransformOrFail[[]string, []V](tmp)
 	case float64:
-		tmp := TypeTransformOrFail[[]V, []float64](subject)
+		tmp := TypeTransformOrFail[[]V, []float64](Clone(subject))
 		sort.Float64s(tmp)
 		response = TypeTransformOrFail[[]float64, []V](tmp)
 	}
@@ -295,15 +299,15 @@ func SortDesc[V constracts.SortInterFaceGenerics](subject []V) (response []V) {
 	item := subject[0]
 	switch TypeTransformOrFail[V, interface{}](item).(type) {
 	case int:
-		tmp := TypeTransformOrFail[[]V, []int](subject)
+		tmp := TypeTransformOrFail[[]V, []int](Clone(subject))
 		sort.Sort(sort.Reverse(sort.IntSlice(tmp)))
 		response = TypeTransformOrFail[[]int, []V](tmp)
 	case string:
-		tmp := TypeTransformOrFail[[]V, []string](subject)
+		tmp := TypeTransformOrFail[[]V, []string](Clone(subject))
 		sort.Sort(sort.Reverse(sort.StringSlice(tmp)))
 		response = TypeTransformOrFail[[]string, []V](tmp)
 	case float64:
-		tmp := TypeTransformOrFail[[]V, []float64](subject)
+		tmp := TypeTransformOrFail[[]V, []float64](Clone(subject))
 		sort.Sort(sort.Reverse(sort.Float64Slice(tmp)))
 		response = TypeTransformOrFail[[]float64, []V](tmp)
 	}
@@ -329,9 +333,9 @@ func sortSlice[V any, ST constracts.SortInterFaceGenerics](subject []V, callback
 		sortContain[index] = sortData
 	}
 	if isAsc {
-		Sort(sortContain)
+		sortContain = Sort(sortContain)
 	} else {
-		SortDesc(sortContain)
+		sortContain = SortDesc(sortContain)
 	}
 	for index, item := range sortContain {
 		response[index] = subject[sortMap[item]]
@@ -800,6 +804,202 @@ func IntersectByKeys[K comparable, V any](subject map[K]V, refs map[K]V) (respon
 	return response
 }
 
+// keySet [K comparable, V any]
+//  @Description: Build a set of the keys produced by the callback.
+//  @param subject
+//  @param callback
+//  @return response
+func keySet[K comparable, V any](subject []V, callback func(V) K) (response map[K]struct{}) {
+	response = make(map[K]struct{}, len(subject))
+	for _, item := range subject {
+		response[callback(item)] = struct{}{}
+	}
+	return response
+}
+
+// DiffBy [K comparable, V any]
+//  @Description: Get the items whose key is not produced by any of the given items.
+//  @param subject
+//  @param refs
+//  @param callback
+//  @return response
+func DiffBy[K comparable, V any](subject []V, refs []V, callback func(V) K) (response []V) {
+	if subject == nil {
+		return response
+	}
+	refKeys := keySet(refs, callback)
+	response = []V{}
+	for _, item := range subject {
+		if _, ok := refKeys[callback(item)]; !ok {
+			response = append(response, item)
+		}
+	}
+	return response
+}
+
+// DiffUsing [V any]
+//  @Description: Get the items that are not equal to any of the given items by the callback.
+//  @param subject
+//  @param refs
+//  @param callback
+//  @return response
+func DiffUsing[V any](subject []V, refs []V, callback func(V, V) bool) (response []V) {
+	if subject == nil {
+		return response
+	}
+	response = []V{}
+	for _, item := range subject {
+		if !ContainsBy(refs, item, callback) {
+			response = append(response, item)
+		}
+	}
+	return response
+}
+
+// DiffAssocUsing [K comparable, V any]
+//  @Description: Get the items whose keys are not present or whose values are not equal by the callback.
+//  @param subject
+//  @param refs
+//  @param callback
+//  @return response
+func DiffAssocUsing[K comparable, V any](subject map[K]V, refs map[K]V, callback func(V, V) bool) (response map[K]V) {
+	if subject == nil {
+		return response
+	}
+	response = map[K]V{}
+	for key, item := range subject {
+		if tmp, ok := refs[key]; !ok || !callback(item, tmp) {
+			response[key] = item
+		}
+	}
+	return response
+}
+
+// IntersectBy [K comparable, V any]
+//  @Description: Get the items whose key is produced by one of the given items.
+//  @param subject
+//  @param refs
+//  @param callback
+//  @return response
+func IntersectBy[K comparable, V any](subject []V, refs []V, callback func(V) K) (response []V) {
+	if subject == nil {
+		return response
+	}
+	refKeys := keySet(refs, callback)
+	response = []V{}
+	for _, item := range subject {
+		if _, ok := refKeys[callback(item)]; ok {
+			response = append(response, item)
+		}
+	}
+	return response
+}
+
+// IntersectUsing [V any]
+//  @Description: Get the items that are equal to one of the given items by the callback.
+//  @param subject
+//  @param refs
+//  @param callback
+//  @return response
+func IntersectUsing[V any](subject []V, refs []V, callback func(V, V) bool) (response []V) {
+	if subject == nil {
+		return response
+	}
+	response = []V{}
+	for _, item := range subject {
+		if ContainsBy(refs, item, callback) {
+			response = append(response, item)
+		}
+	}
+	return response
+}
+
+// UniqueBy [V any]
+//  @Description: Return only the last of the items that are equal by the callback, as Unique does, in the
+//  order of the collection.
+//  @param subject
+//  @param callback
+//  @return response
+func UniqueBy[V any](subject []V, callback func(V, V) bool) (response []V) {
+	if subject == nil {
+		return response
+	}
+	response = []V{}
+	for index, item := range subject {
+		if !ContainsBy(subject[index+1:], item, callback) {
+			response = append(response, item)
+		}
+	}
+	return response
+}
+
+// UniqueByKey [K comparable, V any]
+//  @Description: Return only the last of the items that produce the same key, in the order of the collection.
+//  @param subject
+//  @param callback
+//  @return response
+func UniqueByKey[K comparable, V any](subject []V, callback func(V) K) (response []V) {
+	if subject == nil {
+		return response
+	}
+	last := make(map[K]int, len(subject))
+	keys := make([]K, len(subject))
+	for index, item := range subject {
+		keys[index] = callback(item)
+		last[keys[index]] = index
+	}
+	response = []V{}
+	for index, item := range subject {
+		if last[keys[index]] == index {
+			response = append(response, item)
+		}
+	}
+	return response
+}
+
+// ContainsBy [V any]
+//  @Description: Determine if an item equal by the callback exists in the collection.
+//  @param subject
+//  @param item
+//  @param callback
+//  @return response
+func ContainsBy[V any](subject []V, item V, callback func(V, V) bool) (response bool) {
+	_, response = SearchBy(subject, item, callback)
+	return response
+}
+
+// ContainsByKey [K comparable, V any]
+//  @Description: Determine if an item producing the same key as the given item exists in the collection.
+//  @param subject
+//  @param item
+//  @param callback
+//  @return response
+func ContainsByKey[K comparable, V any](subject []V, item V, callback func(V) K) (response bool) {
+	key := callback(item)
+	for _, value := range subject {
+		if callback(value) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchBy [V any]
+//  @Description: Search the collection for an item equal by the callback and return its key if successful.
+//  @param subject
+//  @param search
+//  @param callback
+//  @return response
+//  @return ok
+func SearchBy[V any](subject []V, search V, callback func(V, V) bool) (response int, ok bool) {
+	for index, item := range subject {
+		if callback(item, search) {
+			return index, true
+		}
+	}
+	return response, false
+}
+
 // Min [V constracts.NumberInterFaceGenerics]
 //  @Description:
 //  @param subject
@@ -868,7 +1068,7 @@ func Pop[V any](subject []V) (response []V, item V) {
 	} else if subLen == 1 {
 		return []V{}, subject[0]
 	}
-	return subject[:subLen-1], subject[subLen-1]
+	return Clone(subject[:subLen-1]), subject[subLen-1]
 }
 
 // Prepend [V any]
@@ -877,7 +1077,12 @@ func Pop[V any](subject []V) (response []V, item V) {
 //  @param item
 //  @return response
 func Prepend[V any](subject []V, item ...V) (response []V) {
-	return append(item, subject...)
+	if subject == nil && len(item) == 0 {
+		return response
+	}
+	response = make([]V, 0, len(item)+len(subject))
+	response = append(response, item...)
+	return append(response, subject...)
 }
 
 // Pull [K comparable, V any]
@@ -901,7 +1106,12 @@ func Pull[K comparable, V any](subject map[K]V, key K) (response map[K]V, item V
 //  @param item
 //  @return response
 func Push[V any](subject []V, item ...V) (response []V) {
-	return append(subject, item...)
+	if subject == nil && len(item) == 0 {
+		return response
+	}
+	response = make([]V, 0, len(subject)+len(item))
+	response = append(response, subject...)
+	return append(response, item...)
 }
 
 // Put [K comparable, V any]
@@ -1075,7 +1285,7 @@ func Skip[V any](subject []V, offset int) (response []V) {
 	if offset > len(subject) {
 		return []V{}
 	}
-	return subject[offset:]
+	return Clone(subject[offset:])
 }
 
 // Slice [V any]
@@ -1099,7 +1309,7 @@ func Slice[V any](subject []V, offset int, length int) (response []V) {
 	if last > subLen {
 		last = subLen
 	}
-	return subject[offset:last]
+	return Clone(subject[offset:last])
 }
 
 // Nth [V any]
@@ -1132,14 +1342,14 @@ func Pad[V any](subject []V, size int, item V) (response []V) {
 		size = -size
 	}
 	if len(subject) >= size {
-		return subject
+		return Clone(subject)
 	}
 	result := make([]V, size-len(subject))
 	for i := size - len(subject) - 1; i >= 0; i-- {
 		result[i] = item
 	}
 	if isAfter {
-		return append(subject, result...)
+		return Push(subject, result...)
 	}
 	return append(result, subject...)
 }
@@ -1236,11 +1446,12 @@ func Shuffle[V any](subject []V) (response []V) {
 	if subject == nil {
 		return response
 	}
+	response = Clone(subject)
 	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(subject), func(i, j int) {
-		subject[i], subject[j] = subject[j], subject[i]
+	rand.Shuffle(len(response), func(i, j int) {
+		response[i], response[j] = response[j], response[i]
 	})
-	return subject
+	return response
 }
 
 // Random [V any]
@@ -1338,7 +1549,7 @@ func Shift[V any](subject []V) (response []V, item V) {
 	} else if subLen == 1 {
 		return []V{}, subject[0]
 	}
-	return subject[1:subLen], subject[0]
+	return Clone(subject[1:subLen]), subject[0]
 }
 
 // Times [V any]
@@ -1386,7 +1597,7 @@ func Splice[V any](subject []V, offset, length int) (response []V, other []V) {
 	}
 	subLen := len(subject)
 	if offset > subLen || offset+subLen < 0 {
-		return []V{}, subject
+		return []V{}, Clone(subject)
 	}
 	if offset < 0 {
 		offset += subLen
@@ -1522,3 +1733,112 @@ func Zip[V any](keys []V, values []V) (response [][]V) {
 	}
 	return response
 }
+
+// Clone [V any]
+//  @Description: Get a copy of the collection that does not share memory with the given slice.
+//  @param subject
+//  @return response
+func Clone[V any](subject []V) (response []V) {
+	if subject == nil {
+		return response
+	}
+	response = make([]V, len(subject))
+	copy(response, subject)
+	return response
+}
+
+// normalizeIndex
+//  @Description: Resolve a negative index from the end of the collection and check it is in range.
+//  @param index
+//  @param length
+//  @return int
+func normalizeIndex(index int, length int) int {
+	if index < 0 {
+		index += length
+	}
+	if index < 0 || index >= length {
+		panic(exceptions.NewInvalidParamError(
+			fmt.Sprintf("index out of range, index:%d, length:%d", index, length),
+		))
+	}
+	return index
+}
+
+// InsertAt [V any]
+//  @Description: Insert items before the given position, a negative index counts from the end.
+//  @param subject
+//  @param index
+//  @param item
+//  @return response
+func InsertAt[V any](subject []V, index int, item ...V) (response []V) {
+	subLen := len(subject)
+	if index < 0 {
+		index += subLen
+	}
+	if index < 0 {
+		index = 0
+	}
+	if index > subLen {
+		index = subLen
+	}
+	response = make([]V, 0, subLen+len(item))
+	response = append(response, subject[:index]...)
+	response = append(response, item...)
+	return append(response, subject[index:]...)
+}
+
+// Insert [V any]
+//  @Description: Insert an item before the first item passing the given truth test, or at the end.
+//  @param subject
+//  @param item
+//  @param callback
+//  @return response
+func Insert[V any](subject []V, item V, callback func(int, V) bool) (response []V) {
+	for index, current := range subject {
+		if callback(index, current) {
+			return InsertAt(subject, index, item)
+		}
+	}
+	return Push(subject, item)
+}
+
+// RemoveAt [V any]
+//  @Description: Get and remove the item at the given position, a negative index counts from the end.
+//  @param subject
+//  @param index
+//  @return response
+//  @return item
+func RemoveAt[V any](subject []V, index int) (response []V, item V) {
+	index = normalizeIndex(index, len(subject))
+	response = make([]V, 0, len(subject)-1)
+	response = append(response, subject[:index]...)
+	response = append(response, subject[index+1:]...)
+	return response, subject[index]
+}
+
+// Move [V any]
+//  @Description: Move the item at position from to position to, shifting the items between them.
+//  @param subject
+//  @param from
+//  @param to
+//  @return response
+func Move[V any](subject []V, from, to int) (response []V) {
+	from = normalizeIndex(from, len(subject))
+	to = normalizeIndex(to, len(subject))
+	response, item := RemoveAt(subject, from)
+	return InsertAt(response, to, item)
+}
+
+// Swap [V any]
+//  @Description: Swap the items at the two given positions.
+//  @param subject
+//  @param i
+//  @param j
+//  @return response
+func Swap[V any](subject []V, i, j int) (response []V) {
+	i = normalizeIndex(i, len(subject))
+	j = normalizeIndex(j, len(subject))
+	response = Clone(subject)
+	response[i], response[j] = response[j], response[i]
+	return response
+}
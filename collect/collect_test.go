@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"github.com/melodywen/supports/utils"
 	"log"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -2459,3 +2461,346 @@ func TestZip(t *testing.T) {
 		}
 	})
 }
+func TestClone(t *testing.T) {
+	t.Run("Clone-nil", func(t *testing.T) {
+		var data []int
+		got := Clone(data)
+		var want []int
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Clone() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Clone-score", func(t *testing.T) {
+		data := []int{70, 80, 60}
+		got := Clone(data)
+		got[0] = 100
+		want := []int{70, 80, 60}
+		if !reflect.DeepEqual(data, want) {
+			t.Errorf("Clone() = %v, want %v", data, want)
+		}
+	})
+}
+func TestInsertAt(t *testing.T) {
+	t.Run("InsertAt-nil", func(t *testing.T) {
+		var data []int
+		got := InsertAt(data, 3, 50)
+		want := []int{50}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("InsertAt() = %v, want %v", got, want)
+		}
+	})
+	t.Run("InsertAt-score", func(t *testing.T) {
+		data := []int{70, 80, 60}
+		got := InsertAt(data, 1, 50, 30)
+		want := []int{70, 50, 30, 80, 60}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("InsertAt() = %v, want %v", got, want)
+		}
+	})
+	t.Run("InsertAt-negative", func(t *testing.T) {
+		data := []int{70, 80, 60}
+		got := InsertAt(data, -1, 50)
+		want := []int{70, 80, 50, 60}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("InsertAt() = %v, want %v", got, want)
+		}
+	})
+}
+func TestInsert(t *testing.T) {
+	t.Run("Insert-sorted", func(t *testing.T) {
+		data := []int{10, 20, 30}
+		got := Insert(data, 25, func(key int, value int) bool {
+			return value > 25
+		})
+		want := []int{10, 20, 25, 30}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Insert() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Insert-end", func(t *testing.T) {
+		data := []int{10, 20, 30}
+		got := Insert(data, 35, func(key int, value int) bool {
+			return value > 35
+		})
+		want := []int{10, 20, 30, 35}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Insert() = %v, want %v", got, want)
+		}
+	})
+}
+func TestRemoveAt(t *testing.T) {
+	t.Run("RemoveAt-score", func(t *testing.T) {
+		data := []int{70, 80, 60}
+		got, got1 := RemoveAt(data, 1)
+		want := []int{70, 60}
+		if !reflect.DeepEqual(got, want) || got1 != 80 {
+			t.Errorf("RemoveAt() = %v %v, want %v %v", got, got1, want, 80)
+		}
+	})
+	t.Run("RemoveAt-negative", func(t *testing.T) {
+		data := []int{70, 80, 60}
+		got, got1 := RemoveAt(data, -1)
+		want := []int{70, 80}
+		if !reflect.DeepEqual(got, want) || got1 != 60 {
+			t.Errorf("RemoveAt() = %v %v, want %v %v", got, got1, want, 60)
+		}
+	})
+	t.Run("RemoveAt-out-of-range", func(t *testing.T) {
+		defer func() {
+			if err := recover(); err == nil {
+				t.Errorf("RemoveAt() want panic")
+			}
+		}()
+		RemoveAt([]int{70}, 1)
+	})
+}
+func TestMove(t *testing.T) {
+	t.Run("Move-forward", func(t *testing.T) {
+		data := []string{"a", "b", "c", "d"}
+		got := Move(data, 0, 2)
+		want := []string{"b", "c", "a", "d"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Move() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Move-backward", func(t *testing.T) {
+		data := []string{"a", "b", "c", "d"}
+		got := Move(data, -1, 0)
+		want := []string{"d", "a", "b", "c"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Move() = %v, want %v", got, want)
+		}
+	})
+}
+func TestSwap(t *testing.T) {
+	t.Run("Swap-score", func(t *testing.T) {
+		data := []string{"a", "b", "c"}
+		got := Swap(data, 0, -1)
+		want := []string{"c", "b", "a"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Swap() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Swap-out-of-range", func(t *testing.T) {
+		defer func() {
+			if err := recover(); err == nil {
+				t.Errorf("Swap() want panic")
+			}
+		}()
+		Swap([]string{"a"}, 0, 3)
+	})
+}
+func TestImmutable(t *testing.T) {
+	helpers := map[string]func([]int) []int{
+		"Shuffle":  func(data []int) []int { return Shuffle(data) },
+		"Random":   func(data []int) []int { return Random(data, 3) },
+		"Sort":     func(data []int) []int { return Sort(data) },
+		"SortDesc": func(data []int) []int { return SortDesc(data) },
+		"Push":     func(data []int) []int { return Push(data, 9) },
+		"Prepend":  func(data []int) []int { return Prepend(data, 9) },
+		"Pad":      func(data []int) []int { return Pad(data, 8, 9) },
+		"Skip":     func(data []int) []int { return Skip(data, 1) },
+		"Slice":    func(data []int) []int { return Slice(data, 1, 2) },
+		"InsertAt": func(data []int) []int { return InsertAt(data, 1, 9) },
+		"Move":     func(data []int) []int { return Move(data, 0, 2) },
+		"Swap":     func(data []int) []int { return Swap(data, 0, 2) },
+		"Pop": func(data []int) []int {
+			response, _ := Pop(data)
+			return response
+		},
+		"Shift": func(data []int) []int {
+			response, _ := Shift(data)
+			return response
+		},
+		"RemoveAt": func(data []int) []int {
+			response, _ := RemoveAt(data, 1)
+			return response
+		},
+		"Splice": func(data []int) []int {
+			_, other := Splice(data, 1, 2)
+			return other
+		},
+		"Splice-out-of-range": func(data []int) []int {
+			_, other := Splice(data, 10, 2)
+			return other
+		},
+	}
+	for name, helper := range helpers {
+		t.Run(name, func(t *testing.T) {
+			// spare capacity lets an aliasing append write past len(data)
+			data := make([]int, 4, 10)
+			copy(data, []int{3, 1, 4, 2})
+			backing := data[:cap(data)]
+			want := Clone(backing)
+			got := helper(data)
+			if !reflect.DeepEqual(backing, want) {
+				t.Errorf("%s() modified input = %v, want %v", name, backing, want)
+			}
+			for i := range got {
+				got[i] = -1
+			}
+			if !reflect.DeepEqual(backing, want) {
+				t.Errorf("%s() shares memory with input = %v, want %v", name, backing, want)
+			}
+		})
+	}
+}
+func TestDiffBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Tags []string
+	}
+	t.Run("DiffBy-nil", func(t *testing.T) {
+		var data []user
+		got := DiffBy(data, []user{{ID: 1}}, func(item user) int {
+			return item.ID
+		})
+		var want []user
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("DiffBy() = %v, want %v", got, want)
+		}
+	})
+	t.Run("DiffBy-struct", func(t *testing.T) {
+		data := []user{{ID: 1, Tags: []string{"a"}}, {ID: 2}, {ID: 3}}
+		got := DiffBy(data, []user{{ID: 2, Tags: []string{"b"}}}, func(item user) int {
+			return item.ID
+		})
+		want := []user{{ID: 1, Tags: []string{"a"}}, {ID: 3}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("DiffBy() = %v, want %v", got, want)
+		}
+	})
+}
+func TestDiffUsing(t *testing.T) {
+	t.Run("DiffUsing-tolerance", func(t *testing.T) {
+		data := []float64{1.0, 2.0, 3.0}
+		got := DiffUsing(data, []float64{1.0001, 2.5}, func(a float64, b float64) bool {
+			return math.Abs(a-b) < 0.001
+		})
+		want := []float64{2.0, 3.0}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("DiffUsing() = %v, want %v", got, want)
+		}
+	})
+}
+func TestDiffAssocUsing(t *testing.T) {
+	t.Run("DiffAssocUsing-tolerance", func(t *testing.T) {
+		data := map[string]float64{"english": 60.0001, "mathematics": 70, "language": 80}
+		refs := map[string]float64{"english": 60, "mathematics": 71}
+		got := DiffAssocUsing(data, refs, func(a float64, b float64) bool {
+			return math.Abs(a-b) < 0.001
+		})
+		want := map[string]float64{"mathematics": 70, "language": 80}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("DiffAssocUsing() = %v, want %v", got, want)
+		}
+	})
+}
+func TestIntersectBy(t *testing.T) {
+	t.Run("IntersectBy-slice", func(t *testing.T) {
+		data := [][]int{{1, 2}, {3}, {4, 5}}
+		got := IntersectBy(data, [][]int{{3}, {1, 2}}, func(item []int) string {
+			return fmt.Sprint(item)
+		})
+		want := [][]int{{1, 2}, {3}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("IntersectBy() = %v, want %v", got, want)
+		}
+	})
+}
+func TestIntersectUsing(t *testing.T) {
+	t.Run("IntersectUsing-tolerance", func(t *testing.T) {
+		data := []float64{1.0, 2.0, 3.0}
+		got := IntersectUsing(data, []float64{1.0001, 2.5}, func(a float64, b float64) bool {
+			return math.Abs(a-b) < 0.001
+		})
+		want := []float64{1.0}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("IntersectUsing() = %v, want %v", got, want)
+		}
+	})
+}
+func TestUniqueBy(t *testing.T) {
+	t.Run("UniqueBy-nil", func(t *testing.T) {
+		var data [][]int
+		got := UniqueBy(data, func(a []int, b []int) bool {
+			return reflect.DeepEqual(a, b)
+		})
+		var want [][]int
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("UniqueBy() = %v, want %v", got, want)
+		}
+	})
+	t.Run("UniqueBy-slice", func(t *testing.T) {
+		data := [][]int{{1, 2}, {3}, {1, 2}, {3, 4}}
+		got := UniqueBy(data, func(a []int, b []int) bool {
+			return reflect.DeepEqual(a, b)
+		})
+		want := [][]int{{3}, {1, 2}, {3, 4}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("UniqueBy() = %v, want %v", got, want)
+		}
+	})
+}
+func TestUniqueByKey(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	t.Run("UniqueByKey-nil", func(t *testing.T) {
+		var data []user
+		got := UniqueByKey(data, func(item user) int {
+			return item.ID
+		})
+		var want []user
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("UniqueByKey() = %v, want %v", got, want)
+		}
+	})
+	t.Run("UniqueByKey-last", func(t *testing.T) {
+		data := []user{{1, "tom"}, {2, "amy"}, {1, "tommy"}, {3, "bob"}}
+		got := UniqueByKey(data, func(item user) int {
+			return item.ID
+		})
+		want := []user{{2, "amy"}, {1, "tommy"}, {3, "bob"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("UniqueByKey() = %v, want %v", got, want)
+		}
+		same := Unique(data, func(_ int, item user) int {
+			return item.ID
+		})
+		if !reflect.DeepEqual(got, same) {
+			t.Errorf("UniqueByKey() = %v, Unique() = %v", got, same)
+		}
+	})
+}
+func TestContainsBy(t *testing.T) {
+	t.Run("ContainsBy-tolerance", func(t *testing.T) {
+		data := []float64{1.0, 2.0, 3.0}
+		equal := func(a float64, b float64) bool {
+			return math.Abs(a-b) < 0.001
+		}
+		if !ContainsBy(data, 2.0001, equal) || ContainsBy(data, 2.1, equal) {
+			t.Errorf("ContainsBy() want true for 2.0001 and false for 2.1")
+		}
+	})
+}
+func TestContainsByKey(t *testing.T) {
+	t.Run("ContainsByKey-fold", func(t *testing.T) {
+		data := []string{"Tom", "Amy"}
+		if !ContainsByKey(data, "AMY", strings.ToLower) || ContainsByKey(data, "Bob", strings.ToLower) {
+			t.Errorf("ContainsByKey() want true for AMY and false for Bob")
+		}
+	})
+}
+func TestSearchBy(t *testing.T) {
+	t.Run("SearchBy-slice", func(t *testing.T) {
+		data := [][]int{{1, 2}, {3}}
+		got, ok := SearchBy(data, []int{3}, func(a []int, b []int) bool {
+			return reflect.DeepEqual(a, b)
+		})
+		if got != 1 || !ok {
+			t.Errorf("SearchBy() = %v %v, want %v %v", got, ok, 1, true)
+		}
+	})
+}
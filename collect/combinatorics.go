@@ -0,0 +1,225 @@
+package collect
+
+// pick [V any]
+//  @Description: Copy the items at the given positions into a new slice.
+//  @param subject
+//  @param indexes
+//  @return response
+func pick[V any](subject []V, indexes []int) (response []V) {
+	response = make([]V, len(indexes))
+	for i, index := range indexes {
+		response[i] = subject[index]
+	}
+	return response
+}
+
+// EachCartesianProduct [V any]
+//  @Description: Execute a callback over every combination taking one item from each collection,
+//				the last collection varies fastest. Return false from the callback to stop.
+//  @param callback
+//  @param subject
+func EachCartesianProduct[V any](callback func([]V) bool, subject ...[]V) {
+	for _, items := range subject {
+		if len(items) == 0 {
+			return
+		}
+	}
+	indexes := make([]int, len(subject))
+	for {
+		item := make([]V, len(subject))
+		for i, index := range indexes {
+			item[i] = subject[i][index]
+		}
+		if !callback(item) {
+			return
+		}
+		i := len(indexes) - 1
+		for ; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(subject[i]) {
+				break
+			}
+			indexes[i] = 0
+		}
+		if i < 0 {
+			return
+		}
+	}
+}
+
+// CartesianProduct [V any]
+//  @Description: Get every combination taking one item from each collection.
+//  @param subject
+//  @return response
+func CartesianProduct[V any](subject ...[]V) (response [][]V) {
+	EachCartesianProduct(func(item []V) bool {
+		response = append(response, item)
+		return true
+	}, subject...)
+	return response
+}
+
+// EachPermutation [V any]
+//  @Description: Execute a callback over every ordered arrangement of size items, in lexicographic order of positions.
+//				Return false from the callback to stop.
+//  @param subject
+//  @param size
+//  @param callback
+func EachPermutation[V any](subject []V, size int, callback func([]V) bool) {
+	if size < 0 || size > len(subject) {
+		return
+	}
+	used := make([]bool, len(subject))
+	indexes := make([]int, 0, size)
+	var walk func() bool
+	walk = func() bool {
+		if len(indexes) == size {
+			return callback(pick(subject, indexes))
+		}
+		for index := range subject {
+			if used[index] {
+				continue
+			}
+			used[index] = true
+			indexes = append(indexes, index)
+			next := walk()
+			indexes = indexes[:len(indexes)-1]
+			used[index] = false
+			if !next {
+				return false
+			}
+		}
+		return true
+	}
+	walk()
+}
+
+// Permutations [V any]
+//  @Description: Get every ordered arrangement of size items.
+//  @param subject
+//  @param size
+//  @return response
+func Permutations[V any](subject []V, size int) (response [][]V) {
+	EachPermutation(subject, size, func(item []V) bool {
+		response = append(response, item)
+		return true
+	})
+	return response
+}
+
+// eachCombination [V any]
+//  @Description: Walk the non-decreasing (repetition) or increasing position lists of the given size.
+//  @param subject
+//  @param size
+//  @param repetition
+//  @param callback
+func eachCombination[V any](subject []V, size int, repetition bool, callback func([]V) bool) {
+	if size < 0 || (!repetition && size > len(subject)) || (len(subject) == 0 && size > 0) {
+		return
+	}
+	indexes := make([]int, size)
+	for i := range indexes {
+		if !repetition {
+			indexes[i] = i
+		}
+	}
+	for {
+		if !callback(pick(subject, indexes)) {
+			return
+		}
+		i := size - 1
+		for ; i >= 0; i-- {
+			limit := len(subject) - 1
+			if !repetition {
+				limit = len(subject) - size + i
+			}
+			if indexes[i] < limit {
+				break
+			}
+		}
+		if i < 0 {
+			return
+		}
+		indexes[i]++
+		for j := i + 1; j < size; j++ {
+			if repetition {
+				indexes[j] = indexes[i]
+			} else {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+}
+
+// EachCombination [V any]
+//  @Description: Execute a callback over every unordered selection of size distinct items.
+//				Return false from the callback to stop.
+//  @param subject
+//  @param size
+//  @param callback
+func EachCombination[V any](subject []V, size int, callback func([]V) bool) {
+	eachCombination(subject, size, false, callback)
+}
+
+// Combinations [V any]
+//  @Description: Get every unordered selection of size distinct items.
+//  @param subject
+//  @param size
+//  @return response
+func Combinations[V any](subject []V, size int) (response [][]V) {
+	EachCombination(subject, size, func(item []V) bool {
+		response = append(response, item)
+		return true
+	})
+	return response
+}
+
+// EachCombinationWithRepetition [V any]
+//  @Description: Execute a callback over every unordered selection of size items where an item may repeat.
+//				Return false from the callback to stop.
+//  @param subject
+//  @param size
+//  @param callback
+func EachCombinationWithRepetition[V any](subject []V, size int, callback func([]V) bool) {
+	eachCombination(subject, size, true, callback)
+}
+
+// CombinationsWithRepetition [V any]
+//  @Description: Get every unordered selection of size items where an item may repeat.
+//  @param subject
+//  @param size
+//  @return response
+func CombinationsWithRepetition[V any](subject []V, size int) (response [][]V) {
+	EachCombinationWithRepetition(subject, size, func(item []V) bool {
+		response = append(response, item)
+		return true
+	})
+	return response
+}
+
+// EachPowerSet [V any]
+//  @Description: Execute a callback over every subset, from the empty set up to the whole collection.
+//				Return false from the callback to stop.
+//  @param subject
+//  @param callback
+func EachPowerSet[V any](subject []V, callback func([]V) bool) {
+	next := true
+	for size := 0; size <= len(subject) && next; size++ {
+		EachCombination(subject, size, func(item []V) bool {
+			next = callback(item)
+			return next
+		})
+	}
+}
+
+// PowerSet [V any]
+//  @Description: Get every subset of the collection.
+//  @param subject
+//  @return response
+func PowerSet[V any](subject []V) (response [][]V) {
+	EachPowerSet(subject, func(item []V) bool {
+		response = append(response, item)
+		return true
+	})
+	return response
+}
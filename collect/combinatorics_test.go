@@ -0,0 +1,103 @@
+package collect
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCartesianProduct(t *testing.T) {
+	t.Run("CartesianProduct-empty", func(t *testing.T) {
+		got := CartesianProduct([]int{1, 2}, []int{})
+		var want [][]int
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("CartesianProduct() = %v, want %v", got, want)
+		}
+	})
+	t.Run("CartesianProduct-score", func(t *testing.T) {
+		got := CartesianProduct([]string{"a", "b"}, []string{"x"}, []string{"1", "2"})
+		want := [][]string{{"a", "x", "1"}, {"a", "x", "2"}, {"b", "x", "1"}, {"b", "x", "2"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("CartesianProduct() = %v, want %v", got, want)
+		}
+	})
+	t.Run("EachCartesianProduct-stop", func(t *testing.T) {
+		count := 0
+		EachCartesianProduct(func(item []int) bool {
+			count++
+			return count < 3
+		}, Range(1, 1000), Range(1, 1000), Range(1, 1000))
+		if count != 3 {
+			t.Errorf("EachCartesianProduct() = %v, want %v", count, 3)
+		}
+	})
+}
+func TestPermutations(t *testing.T) {
+	t.Run("Permutations-too-large", func(t *testing.T) {
+		got := Permutations([]int{1, 2}, 3)
+		var want [][]int
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Permutations() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Permutations-score", func(t *testing.T) {
+		got := Permutations([]int{1, 2, 3}, 2)
+		want := [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Permutations() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Permutations-count", func(t *testing.T) {
+		if got := len(Permutations(Range(1, 5), 5)); got != 120 {
+			t.Errorf("Permutations() = %v, want %v", got, 120)
+		}
+	})
+}
+func TestCombinations(t *testing.T) {
+	t.Run("Combinations-zero", func(t *testing.T) {
+		got := Combinations([]int{1, 2}, 0)
+		want := [][]int{{}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Combinations() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Combinations-score", func(t *testing.T) {
+		got := Combinations([]int{1, 2, 3, 4}, 2)
+		want := [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Combinations() = %v, want %v", got, want)
+		}
+	})
+	t.Run("CombinationsWithRepetition-score", func(t *testing.T) {
+		got := CombinationsWithRepetition([]int{1, 2, 3}, 2)
+		want := [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("CombinationsWithRepetition() = %v, want %v", got, want)
+		}
+	})
+	t.Run("CombinationsWithRepetition-larger", func(t *testing.T) {
+		got := CombinationsWithRepetition([]int{1, 2}, 3)
+		want := [][]int{{1, 1, 1}, {1, 1, 2}, {1, 2, 2}, {2, 2, 2}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("CombinationsWithRepetition() = %v, want %v", got, want)
+		}
+	})
+}
+func TestPowerSet(t *testing.T) {
+	t.Run("PowerSet-score", func(t *testing.T) {
+		got := PowerSet([]int{1, 2, 3})
+		want := [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("PowerSet() = %v, want %v", got, want)
+		}
+	})
+	t.Run("EachPowerSet-stop", func(t *testing.T) {
+		count := 0
+		EachPowerSet(Range(1, 60), func(item []int) bool {
+			count++
+			return count < 100
+		})
+		if count != 100 {
+			t.Errorf("EachPowerSet() = %v, want %v", count, 100)
+		}
+	})
+}
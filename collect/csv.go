@@ -0,0 +1,477 @@
+package collect
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CsvOptions
+//  @Description: options for reading and writing csv.
+type CsvOptions struct {
+	Comma      rune                                     // field delimiter, ',' when zero
+	Columns    []string                                 // columns in order, all struct fields or map keys when empty, required for map rows without header
+	NoHeader   bool                                     // do not write or expect a header row
+	Formatters map[string]func(value any) string        // custom formatter by column name
+	Parsers    map[string]func(raw string) (any, error) // custom parser by column name
+}
+
+// csvField
+//  @Description: a struct field mapped to a csv column.
+type csvField struct {
+	name  string
+	index int
+}
+
+// csvStructFields
+//  @Description: Get the csv columns of a struct type, using the csv tag or the field name.
+//  @param structType
+//  @return response
+func csvStructFields(structType reflect.Type) (response []csvField) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag = strings.Split(tag, ",")[0]; tag != "" {
+				name = tag
+			}
+		}
+		response = append(response, csvField{name: name, index: i})
+	}
+	return response
+}
+
+// csvColumns
+//  @Description: Resolve the column names for the row type.
+//  @param rowType
+//  @param rows
+//  @param options
+//  @return response
+func csvColumns(rowType reflect.Type, rows reflect.Value, options CsvOptions) (response []string) {
+	if len(options.Columns) != 0 {
+		return options.Columns
+	}
+	if rowType.Kind() == reflect.Struct {
+		return MapSlice(csvStructFields(rowType), func(_ int, field csvField) string {
+			return field.name
+		})
+	}
+	keys := map[string]bool{}
+	for i := 0; i < rows.Len(); i++ {
+		for _, key := range rows.Index(i).MapKeys() {
+			keys[key.String()] = true
+		}
+	}
+	response = Keys(keys)
+	sort.Strings(response)
+	return response
+}
+
+// csvRowType
+//  @Description: Get the row type to read or write, a struct, a pointer to a struct or a map keyed by string.
+//  @param elemType the element type of the collection
+//  @param options
+//  @return response the struct or map type, pointers are dereferenced
+//  @return err
+func csvRowType(elemType reflect.Type, options CsvOptions) (response reflect.Type, err error) {
+	response = elemType
+	if response.Kind() == reflect.Pointer && response.Elem().Kind() == reflect.Struct {
+		response = response.Elem()
+	}
+	switch {
+	case response.Kind() == reflect.Struct:
+		for _, column := range options.Columns {
+			if _, ok := csvFieldIndexes(response)[column]; !ok {
+				return nil, exceptions.NewInvalidParamError(
+					fmt.Sprintf("csv column %s is not a field of %s", column, response.String()),
+				)
+			}
+		}
+		return response, nil
+	case response.Kind() == reflect.Map && response.Key().Kind() == reflect.String:
+		if options.NoHeader && len(options.Columns) == 0 {
+			return nil, exceptions.NewInvalidParamError("csv map rows without header need the columns option")
+		}
+		return response, nil
+	}
+	return nil, exceptions.NewInvalidParamError(
+		fmt.Sprintf("csv rows must be struct or map with string keys, current type:%s", elemType.String()),
+	)
+}
+
+// csvFieldIndexes
+//  @Description: Get the field index of every csv column of a struct type.
+//  @param structType
+//  @return response
+func csvFieldIndexes(structType reflect.Type) (response map[string]int) {
+	response = map[string]int{}
+	for _, field := range csvStructFields(structType) {
+		response[field.name] = field.index
+	}
+	return response
+}
+
+// formatCsvValue
+//  @Description: Convert a value to its csv text.
+//  @param value
+//  @return string
+//  @return error
+func formatCsvValue(value reflect.Value) (string, error) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "", nil
+		}
+		value = value.Elem()
+	}
+	if item, ok := value.Interface().(time.Time); ok {
+		return item.Format(time.RFC3339), nil
+	}
+	if item, ok := value.Interface().(encoding.TextMarshaler); ok {
+		text, e := item.MarshalText()
+		return string(text), e
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits()), nil
+	}
+	text, e := json.Marshal(value.Interface())
+	return string(text), e
+}
+
+// parseCsvValue
+//  @Description: Parse csv text into the given settable value.
+//  @param value
+//  @param raw
+//  @return error
+func parseCsvValue(value reflect.Value, raw string) (err error) {
+	if value.Kind() == reflect.Pointer {
+		if raw == "" {
+			return nil
+		}
+		item := reflect.New(value.Type().Elem())
+		if err = parseCsvValue(item.Elem(), raw); err == nil {
+			value.Set(item)
+		}
+		return err
+	}
+	if item, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		if raw == "" {
+			return nil
+		}
+		return item.UnmarshalText([]byte(raw))
+	}
+	switch value.Kind() {
+	case reflect.Interface:
+		value.Set(reflect.ValueOf(raw))
+		return nil
+	case reflect.String:
+		value.SetString(raw)
+		return nil
+	}
+	if raw == "" {
+		return nil
+	}
+	switch value.Kind() {
+	case reflect.Bool:
+		var item bool
+		if item, err = strconv.ParseBool(raw); err == nil {
+			value.SetBool(item)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var item int64
+		if item, err = strconv.ParseInt(raw, 10, value.Type().Bits()); err == nil {
+			value.SetInt(item)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var item uint64
+		if item, err = strconv.ParseUint(raw, 10, value.Type().Bits()); err == nil {
+			value.SetUint(item)
+		}
+	case reflect.Float32, reflect.Float64:
+		var item float64
+		if item, err = strconv.ParseFloat(raw, value.Type().Bits()); err == nil {
+			value.SetFloat(item)
+		}
+	default:
+		err = json.Unmarshal([]byte(raw), value.Addr().Interface())
+	}
+	if numErr, ok := err.(*strconv.NumError); ok {
+		err = numErr.Err
+	}
+	return err
+}
+
+// newCsvWriter
+//  @Description: Create a csv writer using the options delimiter.
+//  @param writer
+//  @param options
+//  @return *csv.Writer
+func newCsvWriter(writer io.Writer, options CsvOptions) *csv.Writer {
+	csvWriter := csv.NewWriter(writer)
+	if options.Comma != 0 {
+		csvWriter.Comma = options.Comma
+	}
+	return csvWriter
+}
+
+// WriteCsv [V any]
+//  @Description: Write the rows as csv, one record at a time. Rows may be structs, pointers to structs or
+//  maps keyed by string, an unsupported row type or an unknown column is returned as an error.
+//  @param writer
+//  @param subject
+//  @param options
+//  @return error
+func WriteCsv[V any](writer io.Writer, subject []V, options CsvOptions) error {
+	elemType := reflect.TypeOf((*V)(nil)).Elem()
+	rowType, err := csvRowType(elemType, options)
+	if err != nil {
+		return err
+	}
+	columns := csvColumns(rowType, reflect.ValueOf(subject), options)
+	fields := map[string]int{}
+	if rowType.Kind() == reflect.Struct {
+		fields = csvFieldIndexes(rowType)
+	}
+	csvWriter := newCsvWriter(writer, options)
+	if !options.NoHeader {
+		if e := csvWriter.Write(columns); e != nil {
+			return e
+		}
+	}
+	record := make([]string, len(columns))
+	for _, item := range subject {
+		row := reflect.ValueOf(item)
+		if elemType.Kind() == reflect.Pointer {
+			row = row.Elem()
+		}
+		for i, column := range columns {
+			var value reflect.Value
+			switch {
+			case !row.IsValid():
+				// a nil pointer row is written as empty fields
+			case rowType.Kind() == reflect.Struct:
+				value = row.Field(fields[column])
+			default:
+				value = row.MapIndex(reflect.ValueOf(column).Convert(rowType.Key()))
+			}
+			if !value.IsValid() {
+				record[i] = ""
+				continue
+			}
+			if formatter, ok := options.Formatters[column]; ok {
+				record[i] = formatter(value.Interface())
+				continue
+			}
+			text, e := formatCsvValue(value)
+			if e != nil {
+				return e
+			}
+			record[i] = text
+		}
+		if e := csvWriter.Write(record); e != nil {
+			return e
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// ToCsv [V any]
+//  @Description: Get the collection of structs or maps as csv.
+//  @param subject
+//  @param options
+//  @return string
+func ToCsv[V any](subject []V, options CsvOptions) string {
+	buffer := bytes.Buffer{}
+	if e := WriteCsv(&buffer, subject, options); e != nil {
+		panic(exceptions.NewInvalidParamError(e.Error()))
+	}
+	return buffer.String()
+}
+
+// ToTsv [V any]
+//  @Description: Get the collection of structs or maps as tab separated values.
+//  @param subject
+//  @param options
+//  @return string
+func ToTsv[V any](subject []V, options CsvOptions) string {
+	options.Comma = '\t'
+	return ToCsv(subject, options)
+}
+
+// EachCsv [V any]
+//  @Description: Read csv records one at a time and execute a callback over each decoded row.
+//				Return false from the callback to stop. Errors are *exceptions.ParseError
+//				carrying the line and column of the bad field.
+//  @param reader
+//  @param options
+//  @param callback
+//  @return error
+func EachCsv[V any](reader io.Reader, options CsvOptions, callback func(int, V) bool) error {
+	elemType := reflect.TypeOf((*V)(nil)).Elem()
+	rowType, err := csvRowType(elemType, options)
+	if err != nil {
+		return err
+	}
+	csvReader := csv.NewReader(reader)
+	if options.Comma != 0 {
+		csvReader.Comma = options.Comma
+	}
+	csvReader.FieldsPerRecord = -1
+	read := func() ([]string, error) {
+		record, e := csvReader.Read()
+		var parseErr *csv.ParseError
+		if errors.As(e, &parseErr) {
+			return nil, exceptions.NewParseError(parseErr.Err.Error(), parseErr.Line, parseErr.Column)
+		}
+		return record, e
+	}
+	columns := options.Columns
+	if !options.NoHeader {
+		header, e := read()
+		if e == io.EOF {
+			return nil
+		} else if e != nil {
+			return e
+		}
+		if len(columns) == 0 {
+			columns = header
+		}
+		columns = MapSlice(header, func(_ int, name string) string {
+			if ContainsSlice(columns, name) {
+				return name
+			}
+			return ""
+		})
+	} else if len(columns) == 0 && rowType.Kind() == reflect.Struct {
+		columns = csvColumns(rowType, reflect.Value{}, options)
+	}
+	fields := map[string]int{}
+	if rowType.Kind() == reflect.Struct {
+		fields = csvFieldIndexes(rowType)
+	}
+	for index := 0; ; index++ {
+		record, e := read()
+		if e == io.EOF {
+			return nil
+		} else if e != nil {
+			return e
+		}
+		row := reflect.New(rowType).Elem()
+		if rowType.Kind() == reflect.Map {
+			row.Set(reflect.MakeMapWithSize(rowType, len(record)))
+		}
+		for i, raw := range record {
+			if i >= len(columns) || columns[i] == "" {
+				continue
+			}
+			column := columns[i]
+			var value reflect.Value
+			if rowType.Kind() == reflect.Struct {
+				fieldIndex, ok := fields[column]
+				if !ok {
+					continue
+				}
+				value = row.Field(fieldIndex)
+			} else {
+				value = reflect.New(rowType.Elem()).Elem()
+			}
+			var err error
+			if parser, ok := options.Parsers[column]; ok {
+				var parsed any
+				if parsed, err = parser(raw); err == nil {
+					parsedValue := reflect.ValueOf(parsed)
+					if !parsedValue.IsValid() {
+						parsedValue = reflect.Zero(value.Type())
+					}
+					if !parsedValue.Type().ConvertibleTo(value.Type()) {
+						err = fmt.Errorf("parser returned %s, want %s", parsedValue.Type(), value.Type())
+					} else {
+						value.Set(parsedValue.Convert(value.Type()))
+					}
+				}
+			} else {
+				err = parseCsvValue(value, raw)
+			}
+			if err != nil {
+				line, col := csvReader.FieldPos(i)
+				return exceptions.NewParseError(
+					fmt.Sprintf("invalid value %q for column %s: %s", raw, column, err.Error()), line, col,
+				)
+			}
+			if rowType.Kind() == reflect.Map {
+				row.SetMapIndex(reflect.ValueOf(column).Convert(rowType.Key()), value)
+			}
+		}
+		item := row
+		if elemType.Kind() == reflect.Pointer {
+			item = row.Addr()
+		}
+		if !callback(index, item.Interface().(V)) {
+			return nil
+		}
+	}
+}
+
+// ReadCsv [V any]
+//  @Description: Read all csv records into a collection of structs or maps.
+//  @param reader
+//  @param options
+//  @return response
+//  @return err
+func ReadCsv[V any](reader io.Reader, options CsvOptions) (response []V, err error) {
+	response = []V{}
+	err = EachCsv(reader, options, func(_ int, item V) bool {
+		response = append(response, item)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// FromCsv [V any]
+//  @Description: Parse csv text into a collection of structs or maps.
+//  @param subject
+//  @param options
+//  @return response
+//  @return err
+func FromCsv[V any](subject string, options CsvOptions) (response []V, err error) {
+	return ReadCsv[V](strings.NewReader(subject), options)
+}
+
+// FromTsv [V any]
+//  @Description: Parse tab separated values into a collection of structs or maps.
+//  @param subject
+//  @param options
+//  @return response
+//  @return err
+func FromTsv[V any](subject string, options CsvOptions) (response []V, err error) {
+	options.Comma = '\t'
+	return FromCsv[V](subject, options)
+}
@@ -0,0 +1,205 @@
+package collect
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type csvScore struct {
+	Username int      `csv:"username"`
+	Name     string   `csv:"name"`
+	English  float64  `csv:"english"`
+	Passed   bool     `csv:"passed"`
+	Tags     []string `csv:"tags"`
+	Remark   *string  `csv:"remark"`
+	secret   string
+	Ignored  string `csv:"-"`
+}
+
+func TestToCsv(t *testing.T) {
+	remark := "good, better"
+	data := []csvScore{
+		{Username: 10001, Name: "tom", English: 60.5, Passed: true, Tags: []string{"a"}, Remark: &remark},
+		{Username: 10002, Name: "jack", English: 59, Ignored: "x"},
+	}
+	t.Run("ToCsv-struct", func(t *testing.T) {
+		got := ToCsv(data, CsvOptions{})
+		want := "username,name,english,passed,tags,remark\n" +
+			"10001,tom,60.5,true,\"[\"\"a\"\"]\",\"good, better\"\n" +
+			"10002,jack,59,false,null,\n"
+		if got != want {
+			t.Errorf("ToCsv() = %v, want %v", got, want)
+		}
+	})
+	t.Run("ToCsv-columns-formatter", func(t *testing.T) {
+		got := ToCsv(data, CsvOptions{
+			Columns: []string{"english", "name"},
+			Formatters: map[string]func(value any) string{
+				"english": func(value any) string {
+					return fmt.Sprintf("%.2f", value)
+				},
+			},
+		})
+		want := "english,name\n60.50,tom\n59.00,jack\n"
+		if got != want {
+			t.Errorf("ToCsv() = %v, want %v", got, want)
+		}
+	})
+	t.Run("ToTsv-map", func(t *testing.T) {
+		rows := []map[string]any{
+			{"name": "tom", "english": 60},
+			{"name": "jack", "language": 80},
+		}
+		got := ToTsv(rows, CsvOptions{NoHeader: false})
+		want := "english\tlanguage\tname\n60\t\ttom\n\t80\tjack\n"
+		if got != want {
+			t.Errorf("ToTsv() = %v, want %v", got, want)
+		}
+	})
+	t.Run("ToCsv-unknown-column", func(t *testing.T) {
+		defer func() {
+			if err := recover(); err == nil {
+				t.Errorf("ToCsv() want panic")
+			}
+		}()
+		ToCsv(data, CsvOptions{Columns: []string{"unknown"}})
+	})
+	t.Run("ToCsv-pointer-rows", func(t *testing.T) {
+		got := ToCsv([]*csvScore{&data[1], nil}, CsvOptions{Columns: []string{"username", "name"}})
+		want := "username,name\n10002,jack\n,\n"
+		if got != want {
+			t.Errorf("ToCsv() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestWriteCsv(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(buffer *bytes.Buffer) error
+	}{
+		{name: "WriteCsv-unknown-column", write: func(buffer *bytes.Buffer) error {
+			return WriteCsv(buffer, []csvScore{{}}, CsvOptions{Columns: []string{"unknown"}})
+		}},
+		{name: "WriteCsv-row-type", write: func(buffer *bytes.Buffer) error {
+			return WriteCsv(buffer, []int{1}, CsvOptions{})
+		}},
+		{name: "WriteCsv-map-no-header", write: func(buffer *bytes.Buffer) error {
+			return WriteCsv(buffer, []map[string]int{{"a": 1}}, CsvOptions{NoHeader: true})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buffer := bytes.Buffer{}
+			err := tt.write(&buffer)
+			if _, ok := err.(*exceptions.InvalidParamError); !ok || buffer.Len() != 0 {
+				t.Errorf("WriteCsv() = %q %v, want InvalidParamError", buffer.String(), err)
+			}
+		})
+	}
+}
+
+func TestFromCsv(t *testing.T) {
+	t.Run("FromCsv-round-trip", func(t *testing.T) {
+		remark := "good, better"
+		data := []csvScore{
+			{Username: 10001, Name: "tom", English: 60.5, Passed: true, Tags: []string{"a"}, Remark: &remark},
+			{Username: 10002, Name: "jack", English: 59},
+		}
+		got, err := FromCsv[csvScore](ToCsv(data, CsvOptions{}), CsvOptions{})
+		if err != nil || !reflect.DeepEqual(got, data) {
+			t.Errorf("FromCsv() = %v %v, want %v", got, err, data)
+		}
+	})
+	t.Run("FromCsv-header-order", func(t *testing.T) {
+		got, err := FromCsv[csvScore]("name,extra,username\ntom,1,10001\n", CsvOptions{})
+		want := []csvScore{{Username: 10001, Name: "tom"}}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("FromCsv() = %v %v, want %v", got, err, want)
+		}
+	})
+	t.Run("FromCsv-no-header", func(t *testing.T) {
+		got, err := FromCsv[csvScore]("10001,tom\n", CsvOptions{NoHeader: true, Columns: []string{"username", "name"}})
+		want := []csvScore{{Username: 10001, Name: "tom"}}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("FromCsv() = %v %v, want %v", got, err, want)
+		}
+	})
+	t.Run("FromCsv-parser", func(t *testing.T) {
+		got, err := FromCsv[csvScore]("name,english\ntom,60%\n", CsvOptions{
+			Parsers: map[string]func(raw string) (any, error){
+				"english": func(raw string) (any, error) {
+					var value float64
+					_, err := fmt.Sscanf(raw, "%f%%", &value)
+					return value, err
+				},
+			},
+		})
+		want := []csvScore{{Name: "tom", English: 60}}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("FromCsv() = %v %v, want %v", got, err, want)
+		}
+	})
+	t.Run("FromCsv-pointer-rows", func(t *testing.T) {
+		got, err := FromCsv[*csvScore]("username,name\n10001,tom\n", CsvOptions{})
+		if err != nil || len(got) != 1 || !reflect.DeepEqual(*got[0], csvScore{Username: 10001, Name: "tom"}) {
+			t.Errorf("FromCsv() = %v %v, want tom", got, err)
+		}
+	})
+	t.Run("FromCsv-map-no-header", func(t *testing.T) {
+		_, err := FromCsv[map[string]string]("1,2\n", CsvOptions{NoHeader: true})
+		if _, ok := err.(*exceptions.InvalidParamError); !ok {
+			t.Errorf("FromCsv() error = %v, want InvalidParamError", err)
+		}
+		got, err := FromCsv[map[string]string]("1,2\n", CsvOptions{NoHeader: true, Columns: []string{"a", "b"}})
+		want := []map[string]string{{"a": "1", "b": "2"}}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("FromCsv() = %v %v, want %v", got, err, want)
+		}
+	})
+	t.Run("FromTsv-map", func(t *testing.T) {
+		got, err := FromTsv[map[string]int]("english\tlanguage\n60\t80\n", CsvOptions{})
+		want := []map[string]int{{"english": 60, "language": 80}}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("FromTsv() = %v %v, want %v", got, err, want)
+		}
+	})
+	t.Run("FromCsv-row-error", func(t *testing.T) {
+		_, err := FromCsv[csvScore]("username,name\n10001,tom\nabc,jack\n", CsvOptions{})
+		parseErr, ok := err.(*exceptions.ParseError)
+		if !ok || parseErr.GetLine() != 3 || parseErr.GetColumn() != 1 {
+			t.Errorf("FromCsv() error = %v, want line 3 column 1", err)
+		}
+	})
+	t.Run("FromCsv-syntax-error", func(t *testing.T) {
+		_, err := FromCsv[csvScore]("username,name\n10001,\"tom\n", CsvOptions{})
+		parseErr, ok := err.(*exceptions.ParseError)
+		if !ok || parseErr.GetLine() != 2 {
+			t.Errorf("FromCsv() error = %v, want line 2", err)
+		}
+	})
+}
+
+func TestEachCsv(t *testing.T) {
+	t.Run("EachCsv-stream", func(t *testing.T) {
+		buffer := bytes.Buffer{}
+		err := WriteCsv(&buffer, Times(100, func(index int) csvScore {
+			return csvScore{Username: index}
+		}), CsvOptions{Columns: []string{"username"}})
+		if err != nil {
+			t.Fatalf("WriteCsv() error = %v", err)
+		}
+		count := 0
+		err = EachCsv(strings.NewReader(buffer.String()), CsvOptions{}, func(index int, item csvScore) bool {
+			count++
+			return item.Username < 10
+		})
+		if err != nil || count != 10 {
+			t.Errorf("EachCsv() = %v %v, want %v", count, err, 10)
+		}
+	})
+}
@@ -0,0 +1,212 @@
+package collect
+
+// Pair [A, B any]
+//  @Description: two values of different types kept together.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple [A, B, C any]
+//  @Description: three values of different types kept together.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewPair [A, B any]
+//  @Description: construct
+//  @param first
+//  @param second
+//  @return Pair[A, B]
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// NewTriple [A, B, C any]
+//  @Description: construct
+//  @param first
+//  @param second
+//  @param third
+//  @return Triple[A, B, C]
+func NewTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// Values
+//  @Description: Get both values of the pair.
+//  @receiver p
+//  @return A
+//  @return B
+func (p Pair[A, B]) Values() (A, B) {
+	return p.First, p.Second
+}
+
+// Values
+//  @Description: Get all values of the triple.
+//  @receiver t
+//  @return A
+//  @return B
+//  @return C
+func (t Triple[A, B, C]) Values() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// ZipWith [A, B, R any]
+//  @Description: Merge the items at the same position using the callback, stopping at the shortest collection.
+//  @param first
+//  @param second
+//  @param callback
+//  @return response
+func ZipWith[A, B, R any](first []A, second []B, callback func(A, B) R) (response []R) {
+	if first == nil || second == nil {
+		return response
+	}
+	response = make([]R, Min([]int{len(first), len(second)}))
+	for index := range response {
+		response[index] = callback(first[index], second[index])
+	}
+	return response
+}
+
+// Zip2 [A, B any]
+//  @Description: Pair the items at the same position, stopping at the shortest collection.
+//  @param first
+//  @param second
+//  @return response
+func Zip2[A, B any](first []A, second []B) (response []Pair[A, B]) {
+	return ZipWith(first, second, NewPair[A, B])
+}
+
+// Zip3 [A, B, C any]
+//  @Description: Group the items at the same position, stopping at the shortest collection.
+//  @param first
+//  @param second
+//  @param third
+//  @return response
+func Zip3[A, B, C any](first []A, second []B, third []C) (response []Triple[A, B, C]) {
+	if first == nil || second == nil || third == nil {
+		return response
+	}
+	response = make([]Triple[A, B, C], Min([]int{len(first), len(second), len(third)}))
+	for index := range response {
+		response[index] = NewTriple(first[index], second[index], third[index])
+	}
+	return response
+}
+
+// ZipLongest [A, B any]
+//  @Description: Pair the items at the same position, filling the shorter collection with the given values.
+//  @param first
+//  @param second
+//  @param fillFirst
+//  @param fillSecond
+//  @return response
+func ZipLongest[A, B any](first []A, second []B, fillFirst A, fillSecond B) (response []Pair[A, B]) {
+	if first == nil && second == nil {
+		return response
+	}
+	response = make([]Pair[A, B], Max([]int{len(first), len(second)}))
+	for index := range response {
+		response[index] = NewPair(fillFirst, fillSecond)
+		if index < len(first) {
+			response[index].First = first[index]
+		}
+		if index < len(second) {
+			response[index].Second = second[index]
+		}
+	}
+	return response
+}
+
+// Unzip2 [A, B any]
+//  @Description: Split the pairs back into two collections.
+//  @param subject
+//  @return first
+//  @return second
+func Unzip2[A, B any](subject []Pair[A, B]) (first []A, second []B) {
+	if subject == nil {
+		return first, second
+	}
+	first = make([]A, len(subject))
+	second = make([]B, len(subject))
+	for index, item := range subject {
+		first[index], second[index] = item.Values()
+	}
+	return first, second
+}
+
+// Unzip3 [A, B, C any]
+//  @Description: Split the triples back into three collections.
+//  @param subject
+//  @return first
+//  @return second
+//  @return third
+func Unzip3[A, B, C any](subject []Triple[A, B, C]) (first []A, second []B, third []C) {
+	if subject == nil {
+		return first, second, third
+	}
+	first = make([]A, len(subject))
+	second = make([]B, len(subject))
+	third = make([]C, len(subject))
+	for index, item := range subject {
+		first[index], second[index], third[index] = item.Values()
+	}
+	return first, second, third
+}
+
+// Enumerate [V any]
+//  @Description: Pair every item with its position.
+//  @param subject
+//  @return response
+func Enumerate[V any](subject []V) (response []Pair[int, V]) {
+	if subject == nil {
+		return response
+	}
+	response = make([]Pair[int, V], len(subject))
+	for index, item := range subject {
+		response[index] = NewPair(index, item)
+	}
+	return response
+}
+
+// ToPairs [K comparable, V any]
+//  @Description: Convert the map to key/value pairs, the order of the pairs is not specified.
+//  @param subject
+//  @return response
+func ToPairs[K comparable, V any](subject map[K]V) (response []Pair[K, V]) {
+	if subject == nil {
+		return response
+	}
+	response = make([]Pair[K, V], 0, len(subject))
+	for key, item := range subject {
+		response = append(response, NewPair(key, item))
+	}
+	return response
+}
+
+// CombinePairs [K comparable, V any]
+//  @Description: Build a map from key/value pairs, later pairs win on duplicate keys.
+//  @param subject
+//  @return response
+func CombinePairs[K comparable, V any](subject []Pair[K, V]) (response map[K]V) {
+	if subject == nil {
+		return response
+	}
+	response = make(map[K]V, len(subject))
+	for _, item := range subject {
+		response[item.First] = item.Second
+	}
+	return response
+}
+
+// FlipPairs [A, B any]
+//  @Description: Swap the two values of every pair.
+//  @param subject
+//  @return response
+func FlipPairs[A, B any](subject []Pair[A, B]) (response []Pair[B, A]) {
+	return MapSlice(subject, func(_ int, item Pair[A, B]) Pair[B, A] {
+		return NewPair(item.Second, item.First)
+	})
+}
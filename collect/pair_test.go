@@ -0,0 +1,118 @@
+package collect
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestZipWith(t *testing.T) {
+	t.Run("ZipWith-nil", func(t *testing.T) {
+		var data []int
+		got := ZipWith(data, []string{"a"}, func(a int, b string) string {
+			return fmt.Sprintf("%d-%s", a, b)
+		})
+		var want []string
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ZipWith() = %v, want %v", got, want)
+		}
+	})
+	t.Run("ZipWith-score", func(t *testing.T) {
+		got := ZipWith([]int{1, 2, 3}, []string{"a", "b"}, func(a int, b string) string {
+			return fmt.Sprintf("%d-%s", a, b)
+		})
+		want := []string{"1-a", "2-b"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ZipWith() = %v, want %v", got, want)
+		}
+	})
+}
+func TestZip2(t *testing.T) {
+	t.Run("Zip2-score", func(t *testing.T) {
+		type user struct {
+			Name string
+		}
+		got := Zip2([]int{10001, 10002}, []user{{Name: "tom"}, {Name: "jack"}, {Name: "lucy"}})
+		want := []Pair[int, user]{{10001, user{"tom"}}, {10002, user{"jack"}}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Zip2() = %v, want %v", got, want)
+		}
+	})
+}
+func TestZip3(t *testing.T) {
+	t.Run("Zip3-nil", func(t *testing.T) {
+		var data []int
+		got := Zip3(data, []string{"a"}, []bool{true})
+		var want []Triple[int, string, bool]
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Zip3() = %v, want %v", got, want)
+		}
+	})
+	t.Run("Zip3-score", func(t *testing.T) {
+		got := Zip3([]int{1, 2}, []string{"a", "b"}, []bool{true})
+		want := []Triple[int, string, bool]{{1, "a", true}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Zip3() = %v, want %v", got, want)
+		}
+	})
+}
+func TestZipLongest(t *testing.T) {
+	t.Run("ZipLongest-score", func(t *testing.T) {
+		got := ZipLongest([]int{1, 2, 3}, []string{"a"}, -1, "-")
+		want := []Pair[int, string]{{1, "a"}, {2, "-"}, {3, "-"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ZipLongest() = %v, want %v", got, want)
+		}
+	})
+	t.Run("ZipLongest-first-short", func(t *testing.T) {
+		got := ZipLongest(nil, []string{"a", "b"}, -1, "-")
+		want := []Pair[int, string]{{-1, "a"}, {-1, "b"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ZipLongest() = %v, want %v", got, want)
+		}
+	})
+}
+func TestUnzip2(t *testing.T) {
+	t.Run("Unzip2-score", func(t *testing.T) {
+		first, second := Unzip2(Zip2([]int{1, 2}, []string{"a", "b"}))
+		if !reflect.DeepEqual(first, []int{1, 2}) || !reflect.DeepEqual(second, []string{"a", "b"}) {
+			t.Errorf("Unzip2() = %v %v", first, second)
+		}
+	})
+}
+func TestUnzip3(t *testing.T) {
+	t.Run("Unzip3-score", func(t *testing.T) {
+		first, second, third := Unzip3(Zip3([]int{1, 2}, []string{"a", "b"}, []bool{true, false}))
+		if !reflect.DeepEqual(first, []int{1, 2}) || !reflect.DeepEqual(second, []string{"a", "b"}) ||
+			!reflect.DeepEqual(third, []bool{true, false}) {
+			t.Errorf("Unzip3() = %v %v %v", first, second, third)
+		}
+	})
+}
+func TestEnumerate(t *testing.T) {
+	t.Run("Enumerate-score", func(t *testing.T) {
+		got := Enumerate([]string{"a", "b"})
+		want := []Pair[int, string]{{0, "a"}, {1, "b"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Enumerate() = %v, want %v", got, want)
+		}
+	})
+}
+func TestCombinePairs(t *testing.T) {
+	t.Run("CombinePairs-score", func(t *testing.T) {
+		data := map[string]int{"english": 60, "mathematics": 70}
+		got := CombinePairs(ToPairs(data))
+		if !reflect.DeepEqual(got, data) {
+			t.Errorf("CombinePairs() = %v, want %v", got, data)
+		}
+	})
+}
+func TestFlipPairs(t *testing.T) {
+	t.Run("FlipPairs-score", func(t *testing.T) {
+		got := FlipPairs([]Pair[string, int]{{"english", 60}})
+		want := []Pair[int, string]{{60, "english"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("FlipPairs() = %v, want %v", got, want)
+		}
+	})
+}
@@ -1,7 +1,7 @@
 package exceptions
 
 import (
-	"github.com/melodywen/supports/str"
+	"strings"
 	"testing"
 )
 
@@ -161,7 +161,7 @@ func BenchmarkGetFunctionName(t *testing.B) {
 				if err := recover(); err != nil {
 					e, _ := err.(ErrorInterface)
 					got := e.GetFunctionName()
-					if got == "" || !str.EndsWith(got, tt.want) {
+					if got == "" || !strings.HasSuffix(got, tt.want) {
 						t.Errorf("Error() = %v, want %v", got, tt.want)
 					}
 				}
@@ -233,7 +233,7 @@ func BenchmarkGetFilename(t *testing.B) {
 				if err := recover(); err != nil {
 					e, _ := err.(ErrorInterface)
 					got := e.GetFilename()
-					if got == "" || !str.EndsWith(got, tt.want) {
+					if got == "" || !strings.HasSuffix(got, tt.want) {
 						t.Errorf("Error() = %v, want %v", got, tt.want)
 					}
 				}
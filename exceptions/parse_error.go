@@ -0,0 +1,42 @@
+package exceptions
+
+import "fmt"
+
+// ParseError
+// @Description: error raised while parsing input, records where in the input it happened
+type ParseError struct {
+	BaseError
+	line   int // line number in the input, starting at 1
+	column int // column number in the input, starting at 1
+}
+
+var parseErrorTypeName = "parse"
+
+// NewParseError
+// @Description: parse error construct
+// @param message
+// @param line
+// @param column
+// @return *ParseError
+func NewParseError(message string, line int, column int) *ParseError {
+	data := fmt.Sprintf(`{"line":%d,"column":%d}`, line, column)
+	message = fmt.Sprintf("line %d, column %d: %s", line, column, message)
+	err := NewBaseError(parseErrorTypeName, message, data, 3)
+	return &ParseError{BaseError: *err, line: line, column: column}
+}
+
+// GetLine
+// @Description: get the line number in the input
+// @receiver err
+// @return int
+func (err *ParseError) GetLine() int {
+	return err.line
+}
+
+// GetColumn
+// @Description: get the column number in the input
+// @receiver err
+// @return int
+func (err *ParseError) GetColumn() int {
+	return err.column
+}
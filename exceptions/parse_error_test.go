@@ -0,0 +1,44 @@
+package exceptions
+
+import (
+	"testing"
+)
+
+func BenchmarkNewParseError(t *testing.B) {
+	type args struct {
+		message string
+		line    int
+		column  int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "line and column",
+			args: args{
+				message: "invalid int",
+				line:    3,
+				column:  5,
+			},
+			want: "line 3, column 5: invalid int",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			err := NewParseError(tt.args.message, tt.args.line, tt.args.column)
+			var e ErrorInterface = err
+			if got := e.Error(); got != tt.want {
+				t.Errorf("Error() = %v, want %v", got, tt.want)
+			}
+			if err.GetLine() != tt.args.line || err.GetColumn() != tt.args.column {
+				t.Errorf("GetLine(), GetColumn() = %v, %v, want %v, %v",
+					err.GetLine(), err.GetColumn(), tt.args.line, tt.args.column)
+			}
+			if got := e.GetErrorType(); got != "parse" {
+				t.Errorf("GetErrorType() = %v, want %v", got, "parse")
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package lang
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// pluralCondition matches an explicit count in front of a plural form, {0} or [2,*] or [*,5]. Only
+// numbers are conditions, so a form may start with a {name} placeholder.
+var pluralCondition = regexp.MustCompile(`(?s)^\s*(?:\{\s*(-?\d+)\s*\}|\[(\s*(?:-?\d+|\*)\s*,\s*(?:-?\d+|\*)\s*)\])(.*)$`)
+
+// pluralFamilies the locales of each CLDR plural family, the family decides the form for a count.
+var pluralFamilies = map[string][]string{
+	// a single form
+	"none": {"az", "bo", "dz", "id", "ja", "jv", "ka", "km", "kn", "ko", "lo", "ms", "my", "th", "tr", "vi", "zh"},
+	// one and other
+	"one": {
+		"af", "bn", "bg", "ca", "da", "de", "el", "en", "eo", "es", "et", "eu", "fa", "fi", "fo", "fur", "fy",
+		"gl", "gu", "ha", "he", "hu", "is", "it", "ku", "lb", "ml", "mn", "mr", "nah", "nb", "ne", "nl", "nn",
+		"no", "om", "or", "pa", "pap", "ps", "pt", "so", "sq", "sv", "sw", "ta", "te", "tk", "ur", "zu",
+	},
+	// zero and one are the same form
+	"zero-one": {"am", "bh", "fil", "fr", "gun", "hi", "hy", "ln", "mg", "nso", "ti", "wa", "xbr"},
+	// one, few and many as in Russian
+	"slavic": {"be", "bs", "hr", "ru", "sh", "sr", "uk"},
+	// one, few and other as in Czech
+	"czech":      {"cs", "sk"},
+	"polish":     {"pl"},
+	"irish":      {"ga"},
+	"lithuanian": {"lt"},
+	"slovenian":  {"sl"},
+	"macedonian": {"mk"},
+	"maltese":    {"mt"},
+	"latvian":    {"lv"},
+	"romanian":   {"ro"},
+	"arabic":     {"ar"},
+	"welsh":      {"cy"},
+}
+
+// pluralFamilyOf locale language => family, built from pluralFamilies.
+var pluralFamilyOf = map[string]string{}
+
+func init() {
+	for family, languages := range pluralFamilies {
+		for _, language := range languages {
+			pluralFamilyOf[language] = family
+		}
+	}
+}
+
+// pluralIndex
+// @Description: Get which form of a message to use for a count, following the CLDR plural rules of the
+// locale's language. Unknown languages use the English rule.
+// @param locale
+// @param count
+// @return int
+func pluralIndex(locale string, count int) int {
+	n := count
+	if n < 0 {
+		n = -n
+	}
+	language := strings.ToLower(locale)
+	if index := strings.IndexAny(language, "_-"); index >= 0 {
+		language = language[:index]
+	}
+	switch pluralFamilyOf[language] {
+	case "none":
+		return 0
+	case "zero-one":
+		if n == 0 || n == 1 {
+			return 0
+		}
+		return 1
+	case "slavic":
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20):
+			return 1
+		}
+		return 2
+	case "czech":
+		switch {
+		case n == 1:
+			return 0
+		case n >= 2 && n <= 4:
+			return 1
+		}
+		return 2
+	case "polish":
+		switch {
+		case n == 1:
+			return 0
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return 1
+		}
+		return 2
+	case "irish":
+		switch {
+		case n == 1:
+			return 0
+		case n == 2:
+			return 1
+		}
+		return 2
+	case "lithuanian":
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return 0
+		case n%10 >= 2 && (n%100 < 10 || n%100 >= 20):
+			return 1
+		}
+		return 2
+	case "slovenian":
+		switch n % 100 {
+		case 1:
+			return 0
+		case 2:
+			return 1
+		case 3, 4:
+			return 2
+		}
+		return 3
+	case "macedonian":
+		if n%10 == 1 {
+			return 0
+		}
+		return 1
+	case "maltese":
+		switch {
+		case n == 1:
+			return 0
+		case n == 0 || (n%100 > 1 && n%100 < 11):
+			return 1
+		case n%100 > 10 && n%100 < 20:
+			return 2
+		}
+		return 3
+	case "latvian":
+		switch {
+		case n == 0:
+			return 0
+		case n%10 == 1 && n%100 != 11:
+			return 1
+		}
+		return 2
+	case "romanian":
+		switch {
+		case n == 1:
+			return 0
+		case n == 0 || (n%100 > 0 && n%100 < 20):
+			return 1
+		}
+		return 2
+	case "arabic":
+		switch {
+		case n == 0:
+			return 0
+		case n == 1:
+			return 1
+		case n == 2:
+			return 2
+		case n%100 >= 3 && n%100 <= 10:
+			return 3
+		case n%100 >= 11 && n%100 <= 99:
+			return 4
+		}
+		return 5
+	case "welsh":
+		switch n {
+		case 1:
+			return 0
+		case 2:
+			return 1
+		case 8, 11:
+			return 2
+		}
+		return 3
+	}
+	if n == 1 {
+		return 0
+	}
+	return 1
+}
+
+// matchPluralCondition
+// @Description: Determine if a count meets an explicit condition, {n} for an exact count or [from,to]
+// for an inclusive range where * leaves a side open.
+// @param condition
+// @param count
+// @return bool
+func matchPluralCondition(condition string, count int) bool {
+	bound := func(value string, whenOpen int) (int, bool) {
+		value = strings.TrimSpace(value)
+		if value == "*" {
+			return whenOpen, true
+		}
+		number, e := strconv.Atoi(value)
+		return number, e == nil
+	}
+	if from, to, ok := strings.Cut(condition, ","); ok {
+		low, lowOk := bound(from, count)
+		high, highOk := bound(to, count)
+		return lowOk && highOk && count >= low && count <= high
+	}
+	exact, ok := bound(condition, count)
+	return ok && exact == count
+}
+
+// splitPluralForms
+// @Description: Split a message into its plural forms on the | that are outside braces, the | of a
+// {name|filter} placeholder belongs to its form.
+// @param message
+// @return []string
+func splitPluralForms(message string) []string {
+	var forms []string
+	depth, start := 0, 0
+	for i := 0; i < len(message); i++ {
+		switch message[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth > 0 {
+				depth--
+			}
+		case '|':
+			if depth == 0 {
+				forms = append(forms, message[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(forms, message[start:])
+}
+
+// ChoosePlural
+// @Description: Choose the form of a message for a count. Forms are separated by |, a form may start
+// with an explicit condition ({0} none|{1} one|[2,*] many), otherwise the CLDR rule of the locale picks
+// the form by position (one|other in English).
+// @param message
+// @param count
+// @param locale
+// @return string
+func ChoosePlural(message string, count int, locale string) string {
+	forms := splitPluralForms(message)
+	for _, form := range forms {
+		// an exact count is in the first group, a range in the second
+		groups := pluralCondition.FindStringSubmatch(form)
+		if groups != nil && matchPluralCondition(groups[1]+groups[2], count) {
+			return strings.TrimSpace(groups[3])
+		}
+	}
+	for i, form := range forms {
+		if groups := pluralCondition.FindStringSubmatch(form); groups != nil {
+			form = groups[3]
+		}
+		forms[i] = strings.TrimSpace(form)
+	}
+	index := pluralIndex(locale, count)
+	if index >= len(forms) {
+		return forms[0]
+	}
+	return forms[index]
+}
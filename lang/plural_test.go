@@ -0,0 +1,38 @@
+package lang
+
+import "testing"
+
+func BenchmarkChoosePlural(t *testing.B) {
+	tests := []struct {
+		name    string
+		message string
+		count   int
+		locale  string
+		want    string
+	}{
+		{name: "single form", message: "apple", count: 3, locale: "en", want: "apple"},
+		{name: "exact", message: "{0} none|{1} one|[2,*] many", count: 0, locale: "en", want: "none"},
+		{name: "open range", message: "{0} none|{1} one|[2,*] many", count: 100, locale: "en", want: "many"},
+		{name: "open low", message: "[*,0] none|[1,*] some", count: -3, locale: "en", want: "none"},
+		{name: "unmatched conditions count by position", message: "{0} none|one|many", count: 5, locale: "en", want: "one"},
+		{name: "english", message: "one|other", count: 1, locale: "en_US", want: "one"},
+		{name: "french zero", message: "un|autres", count: 0, locale: "fr", want: "un"},
+		{name: "japanese", message: "つ", count: 9, locale: "ja", want: "つ"},
+		{name: "polish few", message: "plik|pliki|plików", count: 22, locale: "pl", want: "pliki"},
+		{name: "polish many", message: "plik|pliki|plików", count: 12, locale: "pl", want: "plików"},
+		{name: "czech few", message: "a|b|c", count: 4, locale: "cs", want: "b"},
+		{name: "arabic", message: "0|1|2|few|many|other", count: 105, locale: "ar", want: "few"},
+		{name: "missing form", message: "one|other", count: 5, locale: "ru", want: "one"},
+		{name: "unknown language", message: "one|other", count: 2, locale: "xx", want: "other"},
+		{name: "placeholder filter", message: "Hello {name|upper}|Hi all", count: 1, locale: "en", want: "Hello {name|upper}"},
+		{name: "placeholder first", message: "{count} apple|{count} apples", count: 3, locale: "en", want: "{count} apples"},
+		{name: "placeholder after condition", message: "{0} none|[1,*] {count|upper} left", count: 2, locale: "en", want: "{count|upper} left"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := ChoosePlural(tt.message, tt.count, tt.locale); got != tt.want {
+				t.Errorf("ChoosePlural() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,326 @@
+// Package lang
+// @Description: translation of messages. Catalogs are JSON or YAML files per locale, nested keys are
+// addressed with dots (validation.required), placeholders are replaced with str.Interpolate and messages
+// may hold plural forms chosen by the CLDR rules of the locale.
+package lang
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"github.com/melodywen/supports/str"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+)
+
+// Translator
+// @Description: message catalogs per locale with a default and a fallback locale, safe for concurrent use.
+type Translator struct {
+	mutex    sync.RWMutex
+	locale   string
+	fallback string
+	catalogs map[string]map[string]string // locale => dotted key => message
+}
+
+// NewTranslator
+// @Description: Translator construct
+// @param locale used when a context carries no locale
+// @param fallback tried when a key is missing from the locale
+// @return *Translator
+func NewTranslator(locale string, fallback string) *Translator {
+	return &Translator{locale: locale, fallback: fallback, catalogs: map[string]map[string]string{}}
+}
+
+// localeContextKey the context key WithLocale stores the locale under.
+type localeContextKey struct{}
+
+// WithLocale
+// @Description: Get a context carrying the locale messages are translated to, for instance the locale
+// of the current request.
+// @param ctx
+// @param locale
+// @return context.Context
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// LocaleFrom
+// @Description: Get the locale stored in a context by WithLocale.
+// @param ctx
+// @return string
+// @return bool
+func LocaleFrom(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	locale, ok := ctx.Value(localeContextKey{}).(string)
+	return locale, ok && locale != ""
+}
+
+// SetLocale
+// @Description: Set the default locale.
+// @receiver t
+// @param locale
+func (t *Translator) SetLocale(locale string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.locale = locale
+}
+
+// SetFallback
+// @Description: Set the fallback locale.
+// @receiver t
+// @param locale
+func (t *Translator) SetFallback(locale string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.fallback = locale
+}
+
+// Locale
+// @Description: Get the locale of the context, or the default locale.
+// @receiver t
+// @param ctx
+// @return string
+func (t *Translator) Locale(ctx context.Context) string {
+	if locale, ok := LocaleFrom(ctx); ok {
+		return locale
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.locale
+}
+
+// flattenMessages
+// @Description: Flatten nested message groups into dotted keys.
+// @param prefix
+// @param messages
+// @param response
+func flattenMessages(prefix string, messages map[string]any, response map[string]string) {
+	for key, value := range messages {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch value := value.(type) {
+		case map[string]any:
+			flattenMessages(key, value, response)
+		case string:
+			response[key] = value
+		case nil:
+			response[key] = ""
+		default:
+			response[key] = fmt.Sprint(value)
+		}
+	}
+}
+
+// AddMessages
+// @Description: Add messages to a locale, nested maps become dotted keys and existing keys are replaced.
+// @receiver t
+// @param locale
+// @param messages
+func (t *Translator) AddMessages(locale string, messages map[string]any) {
+	flat := map[string]string{}
+	flattenMessages("", messages, flat)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.catalogs[locale] == nil {
+		t.catalogs[locale] = map[string]string{}
+	}
+	for key, message := range flat {
+		t.catalogs[locale][key] = message
+	}
+}
+
+// ParseCatalog
+// @Description: Parse a JSON or YAML catalog, chosen by the file extension.
+// @param name file name ending in .json, .yaml or .yml
+// @param data
+// @return map[string]any
+// @return error a ParseError for malformed content, an InvalidParamError for another extension
+func ParseCatalog(name string, data []byte) (map[string]any, error) {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		response := map[string]any{}
+		if e := json.Unmarshal(data, &response); e != nil {
+			line, column := 1, 1
+			if syntax, ok := e.(*json.SyntaxError); ok && syntax.Offset > 0 {
+				// the offset counts the byte the error was found at
+				for _, c := range string(data[:syntax.Offset-1]) {
+					if c == '\n' {
+						line, column = line+1, 1
+					} else {
+						column++
+					}
+				}
+			}
+			return nil, exceptions.NewParseError(fmt.Sprintf("%s: %s", name, e.Error()), line, column)
+		}
+		return response, nil
+	case ".yaml", ".yml":
+		return parseYaml(data)
+	}
+	return nil, exceptions.NewInvalidParamError(fmt.Sprintf("unsupported catalog format:%s", name))
+}
+
+// LoadFS
+// @Description: Load the catalogs in a directory of a file system such as an embed.FS. A file named after
+// its locale (en.json, zh_CN.yaml) holds every message of the locale, a file in a directory named after
+// its locale (en/validation.yaml) holds a group whose keys start with the file name (validation.required).
+// Other files are ignored.
+// @receiver t
+// @param fsys
+// @param dir
+// @return error the error of the first catalog that can not be read or parsed
+func (t *Translator) LoadFS(fsys fs.FS, dir string) error {
+	entries, e := fs.ReadDir(fsys, dir)
+	if e != nil {
+		return e
+	}
+	isCatalog := func(name string) bool {
+		switch strings.ToLower(path.Ext(name)) {
+		case ".json", ".yaml", ".yml":
+			return true
+		}
+		return false
+	}
+	load := func(locale string, group string, file string) error {
+		data, e := fs.ReadFile(fsys, file)
+		if e != nil {
+			return e
+		}
+		messages, e := ParseCatalog(file, data)
+		if e != nil {
+			return e
+		}
+		if group != "" {
+			messages = map[string]any{group: messages}
+		}
+		t.AddMessages(locale, messages)
+		return nil
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() {
+			if isCatalog(name) {
+				if e := load(strings.TrimSuffix(name, path.Ext(name)), "", path.Join(dir, name)); e != nil {
+					return e
+				}
+			}
+			continue
+		}
+		groups, e := fs.ReadDir(fsys, path.Join(dir, name))
+		if e != nil {
+			return e
+		}
+		for _, group := range groups {
+			if group.IsDir() || !isCatalog(group.Name()) {
+				continue
+			}
+			groupName := strings.TrimSuffix(group.Name(), path.Ext(group.Name()))
+			if e := load(name, groupName, path.Join(dir, name, group.Name())); e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+// candidates
+// @Description: Get the locales to look a key up in, in order: the locale, its language and the fallback.
+// The caller holds the lock.
+// @receiver t
+// @param locale
+// @return response
+func (t *Translator) candidates(locale string) (response []string) {
+	response = append(response, locale)
+	if index := strings.IndexAny(locale, "_-"); index > 0 {
+		response = append(response, locale[:index])
+	}
+	if t.fallback != "" && t.fallback != locale {
+		response = append(response, t.fallback)
+	}
+	return response
+}
+
+// message
+// @Description: Find the message of a key for a locale.
+// @receiver t
+// @param locale
+// @param key
+// @return message
+// @return found the locale the message was found in
+// @return ok
+func (t *Translator) message(locale string, key string) (message string, found string, ok bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	for _, candidate := range t.candidates(locale) {
+		if message, ok = t.catalogs[candidate][key]; ok {
+			return message, candidate, true
+		}
+	}
+	return "", "", false
+}
+
+// Has
+// @Description: Determine if a key has a message in the locale of the context or its fallbacks.
+// @receiver t
+// @param ctx
+// @param key
+// @return bool
+func (t *Translator) Has(ctx context.Context, key string) bool {
+	_, _, ok := t.message(t.Locale(ctx), key)
+	return ok
+}
+
+// Get
+// @Description: Translate a key to the locale of the context and replace its placeholders, a missing
+// key is returned as it is so untranslated messages stay readable.
+// @receiver t
+// @param ctx
+// @param key
+// @param replace placeholder values, see str.Interpolate
+// @return string
+func (t *Translator) Get(ctx context.Context, key string, replace map[string]any) string {
+	message, _, ok := t.message(t.Locale(ctx), key)
+	if !ok {
+		message = key
+	}
+	return str.Interpolate(message, replace)
+}
+
+// Choice
+// @Description: Translate a key holding plural forms, choose the form for the count and replace its
+// placeholders. The count is available as :count.
+// @receiver t
+// @param ctx
+// @param key
+// @param count
+// @param replace
+// @return string
+func (t *Translator) Choice(ctx context.Context, key string, count int, replace map[string]any) string {
+	locale := t.Locale(ctx)
+	message, found, ok := t.message(locale, key)
+	if !ok {
+		message, found = key, locale
+	}
+	values := map[string]any{"count": count}
+	for name, value := range replace {
+		values[name] = value
+	}
+	return str.Interpolate(ChoosePlural(message, count, found), values)
+}
+
+// InvalidParamError
+// @Description: Get an invalid param error whose message is translated to the locale of the context.
+// @receiver t
+// @param ctx
+// @param key
+// @param replace
+// @return *exceptions.InvalidParamError
+func (t *Translator) InvalidParamError(ctx context.Context, key string, replace map[string]any) *exceptions.InvalidParamError {
+	return exceptions.NewInvalidParamErrorCustomStack(t.Get(ctx, key, replace), "", 4)
+}
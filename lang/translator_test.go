@@ -0,0 +1,158 @@
+package lang
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"github.com/melodywen/supports/exceptions"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+//go:embed testdata/lang
+var testCatalogs embed.FS
+
+func newTestTranslator(t *testing.B) *Translator {
+	translator := NewTranslator("en", "en")
+	if e := translator.LoadFS(testCatalogs, "testdata/lang"); e != nil {
+		t.Fatalf("LoadFS() error = %v", e)
+	}
+	return translator
+}
+
+func BenchmarkTranslator_Get(t *testing.B) {
+	translator := newTestTranslator(t)
+	zh := WithLocale(context.Background(), "zh_CN")
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		key     string
+		replace map[string]any
+		want    string
+	}{
+		{name: "default locale", ctx: context.Background(), key: "welcome", replace: map[string]any{"name": "taylor"}, want: "Welcome, taylor"},
+		{name: "context locale", ctx: zh, key: "welcome", replace: map[string]any{"name": "泰勒"}, want: "欢迎, 泰勒"},
+		{name: "group", ctx: zh, key: "validation.required", replace: map[string]any{"attribute": "邮箱"}, want: "邮箱 不能为空"},
+		{name: "nested group", ctx: zh, key: "validation.between.numeric",
+			replace: map[string]any{"attribute": "年龄", "min": 1, "max": 9}, want: "年龄 必须介于 1 - 9 之间"},
+		{name: "fallback", ctx: zh, key: "only_en", want: "English only"},
+		{name: "missing", ctx: zh, key: "validation.missing", want: "validation.missing"},
+		{name: "nil context", ctx: nil, key: "only_en", want: "English only"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := translator.Get(tt.ctx, tt.key, tt.replace); got != tt.want {
+				t.Errorf("Get() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkTranslator_Choice(t *testing.B) {
+	translator := newTestTranslator(t)
+	tests := []struct {
+		name    string
+		locale  string
+		key     string
+		count   int
+		replace map[string]any
+		want    string
+	}{
+		{name: "explicit zero", locale: "en", key: "apples", count: 0, want: "no apples"},
+		{name: "explicit one", locale: "en", key: "apples", count: 1, want: "one apple"},
+		{name: "explicit range", locale: "en", key: "apples", count: 7, want: "7 apples"},
+		{name: "english one", locale: "en", key: "files", count: 1, want: "one file"},
+		{name: "english other", locale: "en", key: "files", count: 2, want: "2 files"},
+		{name: "chinese", locale: "zh", key: "files", count: 1, want: "1 个文件"},
+		{name: "russian one", locale: "ru", key: "files", count: 21, want: "21 файл"},
+		{name: "russian few", locale: "ru", key: "files", count: 3, want: "3 файла"},
+		{name: "russian many", locale: "ru", key: "files", count: 11, want: "11 файлов"},
+		{name: "fallback uses its own rule", locale: "ja", key: "files", count: 5, want: "5 files"},
+		{name: "filter placeholder", locale: "en", key: "Hello {name|upper}|Hi all", count: 1,
+			replace: map[string]any{"name": "taylor"}, want: "Hello TAYLOR"},
+		{name: "count placeholder", locale: "en", key: "{count} apple|{count} apples", count: 3, want: "3 apples"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			ctx := WithLocale(context.Background(), tt.locale)
+			if got := translator.Choice(ctx, tt.key, tt.count, tt.replace); got != tt.want {
+				t.Errorf("Choice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkTranslator_Locale(t *testing.B) {
+	translator := NewTranslator("en", "")
+	translator.AddMessages("fr", map[string]any{"hello": "Bonjour"})
+	if got := translator.Locale(context.Background()); got != "en" {
+		t.Errorf("Locale() = %q, want %q", got, "en")
+	}
+	translator.SetLocale("fr")
+	if got := translator.Get(context.Background(), "hello", nil); got != "Bonjour" {
+		t.Errorf("Get() = %q, want %q", got, "Bonjour")
+	}
+	if translator.Has(WithLocale(context.Background(), "de"), "hello") {
+		t.Errorf("Has() = true without a fallback")
+	}
+	translator.SetFallback("fr")
+	if !translator.Has(WithLocale(context.Background(), "de"), "hello") {
+		t.Errorf("Has() = false with a fallback")
+	}
+	if _, ok := LocaleFrom(context.Background()); ok {
+		t.Errorf("LocaleFrom() found a locale in an empty context")
+	}
+}
+
+func BenchmarkTranslator_InvalidParamError(t *testing.B) {
+	translator := newTestTranslator(t)
+	ctx := WithLocale(context.Background(), "zh")
+	err := translator.InvalidParamError(ctx, "validation.required", map[string]any{"attribute": "name"})
+	if !strings.Contains(err.Error(), "name 不能为空") {
+		t.Errorf("InvalidParamError() = %q", err.Error())
+	}
+	if !strings.Contains(err.GetFunctionName(), "BenchmarkTranslator_InvalidParamError") {
+		t.Errorf("InvalidParamError() recorded %q, want the caller", err.GetFunctionName())
+	}
+}
+
+func BenchmarkTranslator_LoadFS(t *testing.B) {
+	tests := []struct {
+		name   string
+		fsys   fstest.MapFS
+		line   int
+		column int
+	}{
+		{name: "json", fsys: fstest.MapFS{"lang/en.json": {Data: []byte("{\n  \"a\": \"b\",\n  \"c\" \"d\"\n}")}}, line: 3, column: 7},
+		{name: "yaml", fsys: fstest.MapFS{"lang/en/auth.yaml": {Data: []byte("failed: ok\nthrottle:\n  - a\n")}}, line: 3, column: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			e := NewTranslator("en", "").LoadFS(tt.fsys, "lang")
+			var parseError *exceptions.ParseError
+			if !errors.As(e, &parseError) {
+				t.Fatalf("LoadFS() error = %v, want a ParseError", e)
+			}
+			if parseError.GetLine() != tt.line || parseError.GetColumn() != tt.column {
+				t.Errorf("LoadFS() error at %d:%d, want %d:%d", parseError.GetLine(), parseError.GetColumn(), tt.line, tt.column)
+			}
+		})
+	}
+	t.Run("missing directory", func(t *testing.B) {
+		if e := NewTranslator("en", "").LoadFS(fstest.MapFS{}, "lang"); e == nil {
+			t.Errorf("LoadFS() error = nil")
+		}
+	})
+}
+
+func BenchmarkParseCatalog(t *testing.B) {
+	got, e := ParseCatalog("en.yml", []byte("a:\n  b: c\n"))
+	if e != nil || got["a"].(map[string]any)["b"] != "c" {
+		t.Errorf("ParseCatalog() = %v, %v", got, e)
+	}
+	var invalid *exceptions.InvalidParamError
+	if _, e := ParseCatalog("en.toml", nil); !errors.As(e, &invalid) {
+		t.Errorf("ParseCatalog() error = %v, want an InvalidParamError", e)
+	}
+}
@@ -0,0 +1,198 @@
+package lang
+
+import (
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"strconv"
+	"strings"
+)
+
+// yamlLine
+// @Description: a line of a YAML catalog with its indentation and comment removed.
+type yamlLine struct {
+	number int // line number, starting at 1
+	indent int
+	text   string
+}
+
+// stripYamlComment
+// @Description: Remove a # comment outside of quotes, a comment starts the line or follows a space.
+// @param text
+// @return string
+func stripYamlComment(text string) string {
+	var quote rune
+	for i, c := range text {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || text[i-1] == ' ' || text[i-1] == '\t'):
+			return strings.TrimRight(text[:i], " \t")
+		}
+	}
+	return strings.TrimRight(text, " \t")
+}
+
+// parseYamlScalar
+// @Description: Decode a plain, single quoted or double quoted scalar.
+// @param text
+// @param line
+// @param column
+// @return string
+// @return error a ParseError for an unterminated or malformed quoted string
+func parseYamlScalar(text string, line int, column int) (string, error) {
+	switch {
+	case strings.HasPrefix(text, `"`):
+		value, e := strconv.Unquote(text)
+		if e != nil {
+			return "", exceptions.NewParseError(fmt.Sprintf("malformed double quoted string %s", text), line, column)
+		}
+		return value, nil
+	case strings.HasPrefix(text, "'"):
+		if len(text) < 2 || !strings.HasSuffix(text, "'") {
+			return "", exceptions.NewParseError(fmt.Sprintf("unterminated single quoted string %s", text), line, column)
+		}
+		return strings.ReplaceAll(text[1:len(text)-1], "''", "'"), nil
+	}
+	return text, nil
+}
+
+// splitYamlKey
+// @Description: Split a "key: value" line at the colon that ends the key, the key may be quoted.
+// @param text
+// @return key
+// @return rest
+// @return ok
+func splitYamlKey(text string) (key string, rest string, ok bool) {
+	if strings.HasPrefix(text, `"`) || strings.HasPrefix(text, "'") {
+		end := strings.IndexByte(text[1:], text[0])
+		if end < 0 || !strings.HasPrefix(text[end+2:], ":") {
+			return "", "", false
+		}
+		return text[:end+2], text[end+3:], true
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] == ':' && (i+1 == len(text) || text[i+1] == ' ') {
+			return strings.TrimSpace(text[:i]), text[i+1:], true
+		}
+	}
+	return "", "", false
+}
+
+// parseYaml
+// @Description: Parse the YAML subset used by catalogs: nested mappings of scalars, plain and quoted
+// scalars, # comments and | or > block scalars. Sequences, anchors and flow collections are not supported.
+// @param data
+// @return map[string]any
+// @return error a ParseError pointing at the offending line
+func parseYaml(data []byte) (map[string]any, error) {
+	var lines []yamlLine
+	raw := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	for i := 0; i < len(raw); i++ {
+		trimmed := strings.TrimLeft(raw[i], " ")
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, exceptions.NewParseError("tabs can not indent yaml", i+1, len(raw[i])-len(trimmed)+1)
+		}
+		text := stripYamlComment(trimmed)
+		if text == "" || text == "---" {
+			continue
+		}
+		indent := len(raw[i]) - len(trimmed)
+		key, rest, ok := splitYamlKey(text)
+		rest = strings.TrimSpace(rest)
+		if ok && (rest == "|" || rest == ">") {
+			// a block scalar takes the following lines indented deeper than its key
+			var block []string
+			blockIndent := -1
+			for i+1 < len(raw) {
+				next := raw[i+1]
+				nextTrimmed := strings.TrimLeft(next, " ")
+				if nextTrimmed != "" && len(next)-len(nextTrimmed) <= indent {
+					break
+				}
+				if nextTrimmed != "" && blockIndent < 0 {
+					blockIndent = len(next) - len(nextTrimmed)
+				}
+				if blockIndent >= 0 && len(next) >= blockIndent {
+					next = next[blockIndent:]
+				} else {
+					next = nextTrimmed
+				}
+				block = append(block, next)
+				i++
+			}
+			for len(block) > 0 && block[len(block)-1] == "" {
+				block = block[:len(block)-1]
+			}
+			separator := "\n"
+			if rest == ">" {
+				separator = " "
+			}
+			lines = append(lines, yamlLine{number: i + 1, indent: indent, text: key + ": " + strconv.Quote(strings.Join(block, separator)+"\n")})
+			continue
+		}
+		lines = append(lines, yamlLine{number: i + 1, indent: indent, text: text})
+	}
+	response, next, e := parseYamlMapping(lines, 0, 0)
+	if e != nil {
+		return nil, e
+	}
+	if next < len(lines) {
+		return nil, exceptions.NewParseError("unexpected indentation", lines[next].number, lines[next].indent+1)
+	}
+	return response, nil
+}
+
+// parseYamlMapping
+// @Description: Parse the mapping whose keys are at the given indentation, starting at lines[start].
+// @param lines
+// @param start
+// @param indent
+// @return response
+// @return next index of the first line after the mapping
+// @return e
+func parseYamlMapping(lines []yamlLine, start int, indent int) (response map[string]any, next int, e error) {
+	response = map[string]any{}
+	next = start
+	for next < len(lines) && lines[next].indent == indent {
+		line := lines[next]
+		if strings.HasPrefix(line.text, "- ") || line.text == "-" {
+			return nil, 0, exceptions.NewParseError("yaml sequences are not supported in catalogs", line.number, indent+1)
+		}
+		rawKey, rest, ok := splitYamlKey(line.text)
+		if !ok {
+			return nil, 0, exceptions.NewParseError(fmt.Sprintf("expected key: value, got %s", line.text), line.number, indent+1)
+		}
+		key, e := parseYamlScalar(rawKey, line.number, indent+1)
+		if e != nil {
+			return nil, 0, e
+		}
+		rest = strings.TrimSpace(rest)
+		next++
+		if rest != "" {
+			if strings.HasPrefix(rest, "[") || strings.HasPrefix(rest, "{") || strings.HasPrefix(rest, "&") ||
+				strings.HasPrefix(rest, "*") {
+				return nil, 0, exceptions.NewParseError("flow collections, anchors and aliases are not supported in catalogs",
+					line.number, indent+len(rawKey)+3)
+			}
+			if response[key], e = parseYamlScalar(rest, line.number, indent+len(rawKey)+3); e != nil {
+				return nil, 0, e
+			}
+			continue
+		}
+		if next < len(lines) && lines[next].indent > indent {
+			if response[key], next, e = parseYamlMapping(lines, next, lines[next].indent); e != nil {
+				return nil, 0, e
+			}
+			continue
+		}
+		response[key] = ""
+	}
+	if next < len(lines) && lines[next].indent > indent {
+		return nil, 0, exceptions.NewParseError("unexpected indentation", lines[next].number, lines[next].indent+1)
+	}
+	return response, next, nil
+}
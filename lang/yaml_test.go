@@ -0,0 +1,66 @@
+package lang
+
+import (
+	"errors"
+	"github.com/melodywen/supports/exceptions"
+	"reflect"
+	"testing"
+)
+
+func BenchmarkParseYaml(t *testing.B) {
+	tests := []struct {
+		name string
+		data string
+		want map[string]any
+	}{
+		{name: "scalars", data: "a: plain text\nb: \"quoted: \\\"x\\\"\"\nc: 'it''s'\n", want: map[string]any{
+			"a": "plain text", "b": `quoted: "x"`, "c": "it's",
+		}},
+		{name: "nested", data: "---\nauth:\n  failed: no\n  nested:\n    deep: yes\nnext: 1\n", want: map[string]any{
+			"auth": map[string]any{"failed": "no", "nested": map[string]any{"deep": "yes"}}, "next": "1",
+		}},
+		{name: "comments", data: "# top\na: b # tail\nc: 'x # y'\nd: e#f\n", want: map[string]any{
+			"a": "b", "c": "x # y", "d": "e#f",
+		}},
+		{name: "literal block", data: "a: |\n  one\n  two\nb: c\n", want: map[string]any{"a": "one\ntwo\n", "b": "c"}},
+		{name: "folded block", data: "a: >\n  one\n  two\n\n", want: map[string]any{"a": "one two\n"}},
+		{name: "empty value", data: "a:\nb: c\n", want: map[string]any{"a": "", "b": "c"}},
+		{name: "time value", data: "at: 10:30\n", want: map[string]any{"at": "10:30"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			got, e := parseYaml([]byte(tt.data))
+			if e != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseYaml() = %v, %v, want %v", got, e, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkParseYamlError(t *testing.B) {
+	tests := []struct {
+		name   string
+		data   string
+		line   int
+		column int
+	}{
+		{name: "tab", data: "a:\n\tb: c\n", line: 2, column: 1},
+		{name: "sequence", data: "a:\n  - b\n", line: 2, column: 3},
+		{name: "flow", data: "a: [b, c]\n", line: 1, column: 4},
+		{name: "no key", data: "a: b\nplain\n", line: 2, column: 1},
+		{name: "bad indentation", data: "a:\n    b: c\n  d: e\n", line: 3, column: 3},
+		{name: "unterminated quote", data: "a: 'b\n", line: 1, column: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			_, e := parseYaml([]byte(tt.data))
+			var parseError *exceptions.ParseError
+			if !errors.As(e, &parseError) {
+				t.Fatalf("parseYaml() error = %v, want a ParseError", e)
+			}
+			if parseError.GetLine() != tt.line || parseError.GetColumn() != tt.column {
+				t.Errorf("parseYaml() error at %d:%d, want %d:%d", parseError.GetLine(), parseError.GetColumn(), tt.line, tt.column)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+// Package number
+// @Description: formatting of numbers for people: grouping and decimal separators per locale, currencies,
+// percentages, file sizes, abbreviations, ordinals and spelled out numbers. Every function works for all
+// the types of constracts.NumberInterFaceGenerics.
+package number
+
+import (
+	"fmt"
+	"github.com/melodywen/supports/constracts"
+	"github.com/melodywen/supports/exceptions"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// localeFormat
+// @Description: how a locale writes numbers.
+type localeFormat struct {
+	group    string // between groups of three integer digits
+	decimal  string // between the integer and the fraction
+	currency string // where the number and the symbol go, ¤ is the symbol and # the number
+	percent  string // where the number and the percent sign go
+}
+
+// localeFormats the formats of the supported languages, following CLDR.
+var localeFormats = map[string]localeFormat{
+	"en": {group: ",", decimal: ".", currency: "¤#", percent: "#%"},
+	"zh": {group: ",", decimal: ".", currency: "¤#", percent: "#%"},
+	"ja": {group: ",", decimal: ".", currency: "¤#", percent: "#%"},
+	"de": {group: ".", decimal: ",", currency: "#\u00a0¤", percent: "#\u00a0%"},
+	"it": {group: ".", decimal: ",", currency: "#\u00a0¤", percent: "#%"},
+	"fr": {group: "\u202f", decimal: ",", currency: "#\u00a0¤", percent: "#\u202f%"},
+	"ru": {group: "\u00a0", decimal: ",", currency: "#\u00a0¤", percent: "#\u00a0%"},
+}
+
+// currencySymbols the symbols of common currencies, per language when it differs from the default.
+var currencySymbols = map[string]map[string]string{
+	"USD": {"": "$", "zh": "US$", "ja": "$"},
+	"EUR": {"": "€"},
+	"GBP": {"": "£"},
+	"JPY": {"": "¥", "ja": "￥", "zh": "JP¥"},
+	"CNY": {"": "CN¥", "zh": "¥", "ja": "元"},
+	"KRW": {"": "₩"},
+	"INR": {"": "₹"},
+	"RUB": {"": "RUB", "ru": "₽"},
+}
+
+// currencyDigits the fraction digits of currencies that do not use two.
+var currencyDigits = map[string]int{"JPY": 0, "KRW": 0, "VND": 0, "CLP": 0, "ISK": 0, "BHD": 3, "KWD": 3, "OMR": 3}
+
+// language
+// @Description: Get the language of a locale, en for en_US or zh-Hans-CN.
+// @param locale
+// @return string
+func language(locale string) string {
+	locale = strings.ToLower(locale)
+	if index := strings.IndexAny(locale, "_-"); index >= 0 {
+		locale = locale[:index]
+	}
+	return locale
+}
+
+// formatOf
+// @Description: Get the format of a locale, locales of unknown languages use English.
+// @param locale
+// @return localeFormat
+func formatOf(locale string) localeFormat {
+	if format, ok := localeFormats[language(locale)]; ok {
+		return format
+	}
+	return localeFormats["en"]
+}
+
+// digits
+// @Description: Get the plain decimal digits of a number, integers are exact whatever their size.
+// @param n
+// @param precision digits after the point, -1 for as many as the value needs
+// @return string
+func digits[N constracts.NumberInterFaceGenerics](n N, precision int) string {
+	var response string
+	switch any(n).(type) {
+	case float32:
+		return strconv.FormatFloat(float64(n), 'f', precision, 32)
+	case float64:
+		return strconv.FormatFloat(float64(n), 'f', precision, 64)
+	case uint, uint8, uint32, uint64:
+		response = strconv.FormatUint(uint64(n), 10)
+	default:
+		response = strconv.FormatInt(int64(n), 10)
+	}
+	if precision > 0 {
+		response += "." + strings.Repeat("0", precision)
+	}
+	return response
+}
+
+// localize
+// @Description: Write plain decimal digits with the separators of a locale.
+// @param plain such as -1234.5
+// @param format
+// @return string
+func localize(plain string, format localeFormat) string {
+	switch plain {
+	case "NaN":
+		return "NaN"
+	case "+Inf":
+		return "∞"
+	case "-Inf":
+		return "-∞"
+	}
+	sign := ""
+	if strings.HasPrefix(plain, "-") {
+		sign, plain = "-", plain[1:]
+	}
+	integer, fraction, _ := strings.Cut(plain, ".")
+	if strings.Trim(integer+fraction, "0") == "" {
+		// -0.00 is written without its sign
+		sign = ""
+	}
+	var builder strings.Builder
+	builder.WriteString(sign)
+	for i, c := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			builder.WriteString(format.group)
+		}
+		builder.WriteRune(c)
+	}
+	if fraction != "" {
+		builder.WriteString(format.decimal + fraction)
+	}
+	return builder.String()
+}
+
+// Format
+// @Description: Format a number with the grouping and decimal separators of a locale, 1,234.5 in en,
+// 1.234,5 in de and 1 234,5 in fr. Unknown locales are formatted as en.
+// @param n
+// @param precision digits after the decimal separator, -1 for as many as the value needs
+// @param locale such as en, de_DE or zh-CN
+// @return string
+func Format[N constracts.NumberInterFaceGenerics](n N, precision int, locale string) string {
+	return localize(digits(n, precision), formatOf(locale))
+}
+
+// Currency
+// @Description: Format an amount of money in a locale, $1,234.50 in en and 1.234,50 € in de. Amounts
+// use the fraction digits of the currency, none for JPY.
+// @param n
+// @param currency ISO 4217 code such as USD, EUR or CNY, USD when empty
+// @param locale
+// @return string
+func Currency[N constracts.NumberInterFaceGenerics](n N, currency string, locale string) string {
+	currency = strings.ToUpper(currency)
+	if currency == "" {
+		currency = "USD"
+	}
+	precision, ok := currencyDigits[currency]
+	if !ok {
+		precision = 2
+	}
+	symbol := currency
+	if symbols, ok := currencySymbols[currency]; ok {
+		if symbol, ok = symbols[language(locale)]; !ok {
+			symbol = symbols[""]
+		}
+	}
+	format := formatOf(locale)
+	amount := localize(digits(n, precision), format)
+	sign := ""
+	if strings.HasPrefix(amount, "-") {
+		sign, amount = "-", amount[1:]
+	}
+	pattern := format.currency
+	if strings.HasPrefix(pattern, "¤") && symbol != "" && isLetter(symbol[len(symbol)-1]) {
+		// a code such as CHF is kept apart from the number
+		symbol += "\u00a0"
+	}
+	return sign + strings.Replace(strings.Replace(pattern, "#", amount, 1), "¤", symbol, 1)
+}
+
+// isLetter
+// @Description: Determine if a byte is an ASCII letter.
+// @param c
+// @return bool
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// Percentage
+// @Description: Format a percentage in a locale, 12.5 gives 12.5% in en and 12,5 % in de.
+// @param n the percentage, 12.5 for 12.5%
+// @param precision
+// @param locale
+// @return string
+func Percentage[N constracts.NumberInterFaceGenerics](n N, precision int, locale string) string {
+	format := formatOf(locale)
+	return strings.Replace(format.percent, "#", localize(digits(n, precision), format), 1)
+}
+
+// trimFraction
+// @Description: Remove the trailing zeros of a fraction, and the point when nothing is left.
+// @param plain
+// @return string
+func trimFraction(plain string) string {
+	if !strings.Contains(plain, ".") {
+		return plain
+	}
+	return strings.TrimSuffix(strings.TrimRight(plain, "0"), ".")
+}
+
+// scale
+// @Description: Divide a number by the largest power of base below it, rounding to precision and
+// moving up a unit when the rounding reaches the base.
+// @param value
+// @param base
+// @param units how many powers of base there are names for
+// @param precision
+// @return scaled the rounded number in the unit
+// @return unit the power of base, 0 when the number is smaller than base
+func scale(value float64, base float64, units int, precision int) (scaled string, unit int) {
+	magnitude := math.Abs(value)
+	for unit < units && magnitude >= base {
+		magnitude /= base
+		unit++
+	}
+	scaled = strconv.FormatFloat(magnitude, 'f', precision, 64)
+	if rounded, _ := strconv.ParseFloat(scaled, 64); rounded >= base && unit < units {
+		unit++
+		scaled = strconv.FormatFloat(rounded/base, 'f', precision, 64)
+	}
+	if value < 0 && strings.Trim(scaled, "0.") != "" {
+		scaled = "-" + scaled
+	}
+	return scaled, unit
+}
+
+// FileSize
+// @Description: Format a number of bytes with the largest unit below it, 1.5 KB for 1500 bytes in
+// decimal units, 1.5 KiB for 1536 bytes in binary units.
+// @param bytes
+// @param precision digits after the point, bytes are always whole
+// @param binary count in powers of 1024 with KiB, MiB, otherwise in powers of 1000 with KB, MB
+// @return string
+func FileSize[N constracts.NumberInterFaceGenerics](bytes N, precision int, binary bool) string {
+	units := []string{"B", "KB", "MB", "GB", "TB", "PB", "EB", "ZB", "YB"}
+	base := 1000.0
+	if binary {
+		units = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB", "ZiB", "YiB"}
+		base = 1024
+	}
+	if precision < 0 {
+		panic(exceptions.NewInvalidParamError(fmt.Sprintf("precision can not be negative:%d", precision)))
+	}
+	scaled, unit := scale(float64(bytes), base, len(units)-1, precision)
+	if unit == 0 {
+		return digits(bytes, 0) + " B"
+	}
+	return scaled + " " + units[unit]
+}
+
+// humanize
+// @Description: Write a number with the unit of its thousands.
+// @param value
+// @param precision the most digits after the point, trailing zeros are removed
+// @param units the names of thousand, million, billion and so on
+// @param separator between the number and the unit
+// @return string
+func humanize(value float64, precision int, units []string, separator string) string {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return localize(strconv.FormatFloat(value, 'f', -1, 64), localeFormats["en"])
+	}
+	if precision < 0 {
+		panic(exceptions.NewInvalidParamError(fmt.Sprintf("precision can not be negative:%d", precision)))
+	}
+	scaled, unit := scale(value, 1000, len(units), precision)
+	scaled = trimFraction(scaled)
+	if unit == 0 {
+		return scaled
+	}
+	return scaled + separator + units[unit-1]
+}
+
+// ForHumans
+// @Description: Write a large number with words for its size, 1.2 thousand or 3.4 million.
+// @param n
+// @param precision the most digits after the point, trailing zeros are removed
+// @return string
+func ForHumans[N constracts.NumberInterFaceGenerics](n N, precision int) string {
+	return humanize(float64(n), precision, []string{"thousand", "million", "billion", "trillion", "quadrillion"}, " ")
+}
+
+// Abbreviate
+// @Description: Write a large number with a letter for its size, 1.2K, 3.4M, 5B, 6T or 7Q.
+// @param n
+// @param precision the most digits after the point, trailing zeros are removed
+// @return string
+func Abbreviate[N constracts.NumberInterFaceGenerics](n N, precision int) string {
+	return humanize(float64(n), precision, []string{"K", "M", "B", "T", "Q"}, "")
+}
+
+// Ordinal
+// @Description: Write a number as an English ordinal, 1st, 2nd, 3rd, 4th, 11th or 21st. Fractions are
+// dropped.
+// @param n
+// @return string
+func Ordinal[N constracts.NumberInterFaceGenerics](n N) string {
+	plain := digits(n, 0)
+	switch any(n).(type) {
+	case float32, float64:
+		value := math.Trunc(float64(n))
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			panic(exceptions.NewInvalidParamError(fmt.Sprintf("can not write %v as an ordinal", n)))
+		}
+		plain = strconv.FormatFloat(value, 'f', 0, 64)
+		if plain == "-0" {
+			plain = "0"
+		}
+	}
+	last := plain[len(plain)-1]
+	tens := byte('0')
+	if len(plain) > 1 {
+		tens = plain[len(plain)-2]
+	}
+	suffix := "th"
+	if tens != '1' {
+		switch last {
+		case '1':
+			suffix = "st"
+		case '2':
+			suffix = "nd"
+		case '3':
+			suffix = "rd"
+		}
+	}
+	return plain + suffix
+}
+
+// Clamp
+// @Description: Limit a number to a range.
+// @param n
+// @param min
+// @param max
+// @return N
+func Clamp[N constracts.NumberInterFaceGenerics](n N, min N, max N) N {
+	if min > max {
+		panic(exceptions.NewInvalidParamError(fmt.Sprintf("min %v can not be greater than max %v", min, max)))
+	}
+	if n < min {
+		return min
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
@@ -0,0 +1,181 @@
+package number
+
+import (
+	"math"
+	"testing"
+)
+
+func BenchmarkFormat(t *testing.B) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "en", got: Format(1234567.891, 2, "en"), want: "1,234,567.89"},
+		{name: "en region", got: Format(1234.5, 1, "en_US"), want: "1,234.5"},
+		{name: "de", got: Format(1234567.891, 2, "de"), want: "1.234.567,89"},
+		{name: "fr", got: Format(1234567.891, 2, "fr-FR"), want: "1\u202f234\u202f567,89"},
+		{name: "zh", got: Format(1234567, 0, "zh_CN"), want: "1,234,567"},
+		{name: "unknown locale", got: Format(1234.5, 1, "xx"), want: "1,234.5"},
+		{name: "negative", got: Format(-1234, 0, "en"), want: "-1,234"},
+		{name: "negative zero", got: Format(-0.001, 2, "en"), want: "0.00"},
+		{name: "integer precision", got: Format(int8(-12), 2, "de"), want: "-12,00"},
+		{name: "shortest", got: Format(float32(0.1), -1, "en"), want: "0.1"},
+		{name: "large uint", got: Format(uint64(math.MaxUint64), 0, "en"), want: "18,446,744,073,709,551,615"},
+		{name: "large int", got: Format(int64(math.MinInt64), 0, "en"), want: "-9,223,372,036,854,775,808"},
+		{name: "small", got: Format(999, 0, "en"), want: "999"},
+		{name: "infinity", got: Format(math.Inf(-1), 2, "en"), want: "-∞"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if tt.got != tt.want {
+				t.Errorf("Format() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkCurrency(t *testing.B) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "en usd", got: Currency(1234.5, "USD", "en"), want: "$1,234.50"},
+		{name: "default usd", got: Currency(5, "", "en"), want: "$5.00"},
+		{name: "de eur", got: Currency(1234.5, "eur", "de_DE"), want: "1.234,50\u00a0€"},
+		{name: "fr eur", got: Currency(-1234.5, "EUR", "fr"), want: "-1\u202f234,50\u00a0€"},
+		{name: "zh cny", got: Currency(99.9, "CNY", "zh"), want: "¥99.90"},
+		{name: "en cny", got: Currency(99.9, "CNY", "en"), want: "CN¥99.90"},
+		{name: "jpy", got: Currency(1234.5, "JPY", "ja"), want: "￥1,234"},
+		{name: "negative", got: Currency(-3, "GBP", "en"), want: "-£3.00"},
+		{name: "code", got: Currency(10, "CHF", "en"), want: "CHF\u00a010.00"},
+		{name: "three digits", got: Currency(1, "KWD", "en"), want: "KWD\u00a01.000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if tt.got != tt.want {
+				t.Errorf("Currency() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkPercentage(t *testing.B) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "en", got: Percentage(12.5, 1, "en"), want: "12.5%"},
+		{name: "de", got: Percentage(12.5, 1, "de"), want: "12,5\u00a0%"},
+		{name: "fr", got: Percentage(50, 0, "fr"), want: "50\u202f%"},
+		{name: "zh", got: Percentage(uint(100), 0, "zh"), want: "100%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if tt.got != tt.want {
+				t.Errorf("Percentage() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkFileSize(t *testing.B) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "bytes", got: FileSize(512, 2, false), want: "512 B"},
+		{name: "decimal", got: FileSize(1500, 1, false), want: "1.5 KB"},
+		{name: "binary", got: FileSize(1536, 1, true), want: "1.5 KiB"},
+		{name: "precision", got: FileSize(int64(1024*1024), 2, true), want: "1.00 MiB"},
+		{name: "rounding moves up", got: FileSize(999999, 0, false), want: "1 MB"},
+		{name: "large", got: FileSize(uint64(math.MaxUint64), 1, true), want: "16.0 EiB"},
+		{name: "float", got: FileSize(float32(2.5e9), 1, false), want: "2.5 GB"},
+		{name: "negative", got: FileSize(-2048, 0, true), want: "-2 KiB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if tt.got != tt.want {
+				t.Errorf("FileSize() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkForHumans(t *testing.B) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "small", got: ForHumans(999, 1), want: "999"},
+		{name: "thousand", got: ForHumans(1000, 1), want: "1 thousand"},
+		{name: "fraction", got: ForHumans(1234, 1), want: "1.2 thousand"},
+		{name: "million", got: ForHumans(3400000, 2), want: "3.4 million"},
+		{name: "rounding moves up", got: ForHumans(999999, 1), want: "1 million"},
+		{name: "negative", got: ForHumans(-2500000000, 1), want: "-2.5 billion"},
+		{name: "largest unit", got: ForHumans(2e18, 0), want: "2000 quadrillion"},
+		{name: "abbreviate", got: Abbreviate(1200, 1), want: "1.2K"},
+		{name: "abbreviate million", got: Abbreviate(uint32(3456789), 2), want: "3.46M"},
+		{name: "abbreviate whole", got: Abbreviate(int64(5e9), 1), want: "5B"},
+		{name: "abbreviate small fraction", got: Abbreviate(12.345, 1), want: "12.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if tt.got != tt.want {
+				t.Errorf("ForHumans() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkOrdinal(t *testing.B) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "1", got: Ordinal(1), want: "1st"},
+		{name: "2", got: Ordinal(uint8(2)), want: "2nd"},
+		{name: "3", got: Ordinal(3), want: "3rd"},
+		{name: "4", got: Ordinal(4), want: "4th"},
+		{name: "11", got: Ordinal(11), want: "11th"},
+		{name: "12", got: Ordinal(12), want: "12th"},
+		{name: "13", got: Ordinal(113), want: "113th"},
+		{name: "21", got: Ordinal(21), want: "21st"},
+		{name: "102", got: Ordinal(102), want: "102nd"},
+		{name: "0", got: Ordinal(0), want: "0th"},
+		{name: "negative", got: Ordinal(-1), want: "-1st"},
+		{name: "float", got: Ordinal(2.9), want: "2nd"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if tt.got != tt.want {
+				t.Errorf("Ordinal() = %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkClamp(t *testing.B) {
+	if got := Clamp(15, 0, 10); got != 10 {
+		t.Errorf("Clamp() = %v, want %v", got, 10)
+	}
+	if got := Clamp(-1.5, 0, 10); got != 0 {
+		t.Errorf("Clamp() = %v, want %v", got, 0)
+	}
+	if got := Clamp(uint8(5), 1, 9); got != 5 {
+		t.Errorf("Clamp() = %v, want %v", got, 5)
+	}
+	t.Run("min greater than max", func(t *testing.B) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Clamp() did not panic")
+			}
+		}()
+		Clamp(1, 2, 1)
+	})
+}
@@ -0,0 +1,106 @@
+package number
+
+import (
+	"fmt"
+	"github.com/melodywen/supports/constracts"
+	"github.com/melodywen/supports/exceptions"
+	"strconv"
+	"strings"
+)
+
+var (
+	spellOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "eleven", "twelve",
+		"thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	spellTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	spellScales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion", "sextillion", "septillion",
+		"octillion", "nonillion", "decillion",
+	}
+)
+
+// spellHundreds
+// @Description: Spell a number from 1 to 999.
+// @param n
+// @return string
+func spellHundreds(n int) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, spellOnes[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, spellOnes[n])
+	case n%10 == 0:
+		words = append(words, spellTens[n/10])
+	default:
+		words = append(words, spellTens[n/10]+"-"+spellOnes[n%10])
+	}
+	return strings.Join(words, " ")
+}
+
+// spellInteger
+// @Description: Spell the decimal digits of a whole number.
+// @param digits
+// @return string
+func spellInteger(digits string) string {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return spellOnes[0]
+	}
+	groups := (len(digits) + 2) / 3
+	if groups > len(spellScales) {
+		// beyond the largest scale, the number of decillions is spelled in turn (ten thousand decillion)
+		split := len(digits) - (len(spellScales)-1)*3
+		words := spellInteger(digits[:split]) + " " + spellScales[len(spellScales)-1]
+		if rest := strings.TrimLeft(digits[split:], "0"); rest != "" {
+			words += " " + spellInteger(rest)
+		}
+		return words
+	}
+	digits = strings.Repeat("0", groups*3-len(digits)) + digits
+	var words []string
+	for i := 0; i < groups; i++ {
+		value, _ := strconv.Atoi(digits[i*3 : i*3+3])
+		if value == 0 {
+			continue
+		}
+		words = append(words, spellHundreds(value))
+		if scale := spellScales[groups-1-i]; scale != "" {
+			words = append(words, scale)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// Spell
+// @Description: Spell a number in English words, 123 gives one hundred twenty-three, -1.25 gives minus
+// one point two five. Numbers past the decillions count decillions, 1e40 gives ten million decillion.
+// NaN and infinities panic with an InvalidParamError.
+// @param n
+// @return string
+func Spell[N constracts.NumberInterFaceGenerics](n N) string {
+	plain := digits(n, -1)
+	if plain == "NaN" || strings.HasSuffix(plain, "Inf") {
+		panic(exceptions.NewInvalidParamError(fmt.Sprintf("can not spell %s", plain)))
+	}
+	sign := ""
+	if strings.HasPrefix(plain, "-") {
+		sign, plain = "minus ", plain[1:]
+	}
+	integer, fraction, _ := strings.Cut(plain, ".")
+	if strings.Trim(integer+fraction, "0") == "" {
+		sign = ""
+	}
+	words := sign + spellInteger(integer)
+	if fraction != "" {
+		words += " point"
+		for _, digit := range fraction {
+			words += " " + spellOnes[digit-'0']
+		}
+	}
+	return words
+}
@@ -0,0 +1,49 @@
+package number
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func BenchmarkSpell(t *testing.B) {
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{name: "zero", got: Spell(0), want: "zero"},
+		{name: "teen", got: Spell(13), want: "thirteen"},
+		{name: "tens", got: Spell(40), want: "forty"},
+		{name: "hyphen", got: Spell(42), want: "forty-two"},
+		{name: "hundreds", got: Spell(123), want: "one hundred twenty-three"},
+		{name: "round hundreds", got: Spell(500), want: "five hundred"},
+		{name: "thousands", got: Spell(1001), want: "one thousand one"},
+		{name: "skips empty groups", got: Spell(2000000005), want: "two billion five"},
+		{name: "negative", got: Spell(int8(-7)), want: "minus seven"},
+		{name: "fraction", got: Spell(-1.25), want: "minus one point two five"},
+		{name: "float32", got: Spell(float32(0.5)), want: "zero point five"},
+		{name: "decillion", got: Spell(2e33), want: "two decillion"},
+		{name: "past decillion", got: Spell(1e40), want: "ten million decillion"},
+		{name: "past decillion with rest", got: Spell(-1.5e36), want: "minus one thousand five hundred decillion"},
+		{name: "past decillion digits", got: spellInteger("1" + strings.Repeat("0", 35) + "7"), want: "one thousand decillion seven"},
+		{name: "largest float", got: Spell(math.MaxFloat64) != "", want: true},
+		{name: "largest", got: Spell(uint64(math.MaxUint64)),
+			want: "eighteen quintillion four hundred forty-six quadrillion seven hundred forty-four trillion seventy-three billion seven hundred nine million five hundred fifty-one thousand six hundred fifteen"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if tt.got != tt.want {
+				t.Errorf("Spell() = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+	t.Run("not a number", func(t *testing.B) {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Spell() did not panic")
+			}
+		}()
+		Spell(math.NaN())
+	})
+}
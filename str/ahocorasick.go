@@ -0,0 +1,324 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// acNode
+// @Description: a state of the Aho-Corasick automaton, the node of the needle prefix it has read.
+type acNode struct {
+	next   map[rune]int
+	fail   int // the node of the longest proper suffix that is also a needle prefix
+	output int // the index of the needle ending at this node, -1 when none does
+	link   int // the nearest node on the fail chain with an output, -1 when there is none
+	depth  int // the length of the prefix in labels, bytes or runes
+}
+
+// acMinNeedles below this many needles, a search per needle is faster than building the automaton.
+const acMinNeedles = 8
+
+// acMatcher
+// @Description: an Aho-Corasick automaton, it finds every occurrence of a set of needles in a single pass
+// over the haystack, in time linear in the haystack plus the number of matches.
+type acMatcher struct {
+	nodes []acNode
+	fold  bool
+}
+
+// acMatch
+// @Description: an occurrence of a needle, as byte offsets into the haystack.
+type acMatch struct {
+	needle int
+	start  int
+	end    int
+}
+
+// foldKey
+// @Description: Get the smallest rune equal to r under simple case folding, so all the case forms of a
+// letter share an edge of the automaton.
+// @param r
+// @return rune
+func foldKey(r rune) rune {
+	key := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < key {
+			key = f
+		}
+	}
+	return key
+}
+
+// decode
+// @Description: Read the first label of a string. Case sensitive automatons read bytes, so they match
+// exactly as the strings package does, folding ones read runes as strings.EqualFold does.
+// @receiver m
+// @param s
+// @return r
+// @return size
+func (m *acMatcher) decode(s string) (r rune, size int) {
+	if !m.fold {
+		return rune(s[0]), 1
+	}
+	r, size = utf8.DecodeRuneInString(s)
+	return foldKey(r), size
+}
+
+// decodeLast
+// @Description: Read the last label of a string, see decode.
+// @receiver m
+// @param s
+// @return r
+// @return size
+func (m *acMatcher) decodeLast(s string) (r rune, size int) {
+	if !m.fold {
+		return rune(s[len(s)-1]), 1
+	}
+	r, size = utf8.DecodeLastRuneInString(s)
+	return foldKey(r), size
+}
+
+// newACTrie
+// @Description: Build the trie of a set of needles without the fail links, which is all a match
+// anchored at one end of the haystack needs.
+// @param needles
+// @param fold match under Unicode simple case folding
+// @param reverse read the needles from their end, to match at the end of the haystack
+// @return *acMatcher
+func newACTrie(needles []string, fold bool, reverse bool) *acMatcher {
+	m := &acMatcher{nodes: []acNode{{next: map[rune]int{}, output: -1, link: -1}}, fold: fold}
+	for i, needle := range needles {
+		if needle == "" {
+			continue
+		}
+		node := 0
+		for rest := needle; rest != ""; {
+			var r rune
+			var size int
+			if reverse {
+				r, size = m.decodeLast(rest)
+				rest = rest[:len(rest)-size]
+			} else {
+				r, size = m.decode(rest)
+				rest = rest[size:]
+			}
+			child, ok := m.nodes[node].next[r]
+			if !ok {
+				child = len(m.nodes)
+				m.nodes = append(m.nodes, acNode{next: map[rune]int{}, output: -1, link: -1, depth: m.nodes[node].depth + 1})
+				m.nodes[node].next[r] = child
+			}
+			node = child
+		}
+		if m.nodes[node].output < 0 {
+			m.nodes[node].output = i
+		}
+	}
+	return m
+}
+
+// newACMatcher
+// @Description: Build the automaton of a set of needles, empty needles are never matched. When needles
+// repeat, or are equal under folding, the first one is reported.
+// @param needles
+// @param fold match under Unicode simple case folding
+// @return *acMatcher
+func newACMatcher(needles []string, fold bool) *acMatcher {
+	m := newACTrie(needles, fold, false)
+	// the fail links, breadth first so the links of shallower nodes are ready
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[node].next {
+			fail := m.nodes[node].fail
+			for {
+				if next, ok := m.nodes[fail].next[r]; ok {
+					m.nodes[child].fail = next
+					break
+				}
+				if fail == 0 {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if target := m.nodes[child].fail; m.nodes[target].output >= 0 {
+				m.nodes[child].link = target
+			} else {
+				m.nodes[child].link = m.nodes[target].link
+			}
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// step
+// @Description: Move from a node on the next label of the haystack.
+// @receiver m
+// @param node
+// @param r
+// @return int
+func (m *acMatcher) step(node int, r rune) int {
+	for {
+		if next, ok := m.nodes[node].next[r]; ok {
+			return next
+		}
+		if node == 0 {
+			return 0
+		}
+		node = m.nodes[node].fail
+	}
+}
+
+// scan
+// @Description: Report every occurrence of the needles in a haystack, by end offset and from the longest
+// needle down for a shared end. Scanning stops when visit returns false.
+// @receiver m
+// @param haystack
+// @param visit
+func (m *acMatcher) scan(haystack string, visit func(match acMatch) bool) {
+	// starts holds the byte offsets of the labels read so far, folding changes byte lengths, so a match
+	// start is found by its length in labels
+	starts := make([]int, 0, len(haystack))
+	node := 0
+	for offset := 0; offset < len(haystack); {
+		r, size := m.decode(haystack[offset:])
+		starts = append(starts, offset)
+		node = m.step(node, r)
+		offset += size
+		output := node
+		if m.nodes[output].output < 0 {
+			output = m.nodes[output].link
+		}
+		for ; output >= 0; output = m.nodes[output].link {
+			match := acMatch{needle: m.nodes[output].output, start: starts[len(starts)-m.nodes[output].depth], end: offset}
+			if !visit(match) {
+				return
+			}
+		}
+	}
+}
+
+// anchored
+// @Description: Determine if a needle of the trie matches at the start of a haystack, or at its end for
+// a trie of reversed needles, reading no more of the haystack than the longest needle.
+// @receiver m
+// @param haystack
+// @param reverse
+// @return bool
+func (m *acMatcher) anchored(haystack string, reverse bool) bool {
+	node := 0
+	for haystack != "" {
+		var r rune
+		var size int
+		if reverse {
+			r, size = m.decodeLast(haystack)
+			haystack = haystack[:len(haystack)-size]
+		} else {
+			r, size = m.decode(haystack)
+			haystack = haystack[size:]
+		}
+		next, ok := m.nodes[node].next[r]
+		if !ok {
+			return false
+		}
+		if node = next; m.nodes[node].output >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// StartsWithAny
+// @Description: Determine if a given string starts with any one of the given substrings.
+// @param haystack
+// @param needles
+// @return bool
+func StartsWithAny(haystack string, needles []string) bool {
+	if len(needles) < acMinNeedles {
+		for _, needle := range needles {
+			if needle != "" && strings.HasPrefix(haystack, needle) {
+				return true
+			}
+		}
+		return false
+	}
+	return newACTrie(needles, false, false).anchored(haystack, false)
+}
+
+// EndsWithAny
+// @Description: Determine if a given string ends with any one of the given substrings.
+// @param haystack
+// @param needles
+// @return bool
+func EndsWithAny(haystack string, needles []string) bool {
+	if len(needles) < acMinNeedles {
+		for _, needle := range needles {
+			if needle != "" && strings.HasSuffix(haystack, needle) {
+				return true
+			}
+		}
+		return false
+	}
+	return newACTrie(needles, false, true).anchored(haystack, true)
+}
+
+// Strtr
+// @Description: Replace substrings in a single pass, as PHP strtr does. At every position the longest
+// matching key is replaced, and replaced text is never searched again, so the result does not depend on
+// the order of the map. Empty keys are ignored.
+// @param subject
+// @param replacements
+// @return string
+func Strtr(subject string, replacements map[string]string) string {
+	if len(replacements) == 0 || subject == "" {
+		return subject
+	}
+	keys := make([]string, 0, len(replacements))
+	for key := range replacements {
+		keys = append(keys, key)
+	}
+	m := newACMatcher(keys, false)
+	var builder strings.Builder
+	// best is the leftmost longest match seen since last, it is final once the prefix the automaton is
+	// in starts after it, as no later match can start at or before it then
+	best := acMatch{needle: -1}
+	last, node := 0, 0
+	for offset := 0; offset < len(subject) || best.needle >= 0; offset++ {
+		if offset < len(subject) {
+			node = m.step(node, rune(subject[offset]))
+		}
+		end := offset + 1
+		if best.needle >= 0 && (offset == len(subject) || end-m.nodes[node].depth > best.start) {
+			builder.WriteString(subject[last:best.start])
+			builder.WriteString(replacements[keys[best.needle]])
+			// read again from the end of the replaced text, which is never searched, the labels read
+			// since are fewer than the longest key
+			last, offset, node = best.end, best.end-1, 0
+			best.needle = -1
+			continue
+		}
+		// the first output on the link chain is the longest match ending here, so the leftmost one
+		output := node
+		if m.nodes[output].output < 0 {
+			output = m.nodes[output].link
+		}
+		if output < 0 {
+			continue
+		}
+		start := end - m.nodes[output].depth
+		if best.needle < 0 || start < best.start || start == best.start && end > best.end {
+			best = acMatch{needle: m.nodes[output].output, start: start, end: end}
+		}
+	}
+	if last == 0 {
+		return subject
+	}
+	builder.WriteString(subject[last:])
+	return builder.String()
+}
@@ -0,0 +1,185 @@
+package str
+
+import (
+	"strings"
+	"testing"
+)
+
+func BenchmarkStartsWithAny(t *testing.B) {
+	tests := []struct {
+		name     string
+		haystack string
+		needles  []string
+		want     bool
+	}{
+		{name: "first", haystack: "https://example.com", needles: []string{"http://", "https://"}, want: true},
+		{name: "shorter needle", haystack: "https://example.com", needles: []string{"https://www", "http"}, want: true},
+		{name: "none", haystack: "ftp://example.com", needles: []string{"http://", "https://"}, want: false},
+		{name: "longer than haystack", haystack: "ab", needles: []string{"abc"}, want: false},
+		{name: "whole", haystack: "陈先生", needles: []string{"陈先生"}, want: true},
+		{name: "empty needle", haystack: "abc", needles: []string{""}, want: false},
+		{name: "no needles", haystack: "abc", needles: nil, want: false},
+		{name: "invalid bytes", haystack: "\xffabc", needles: []string{"\xfe"}, want: false},
+		{name: "many", haystack: "gopher", needles: strings.Split("a b c d e f g h", " "), want: true},
+		{name: "many none", haystack: "gopher", needles: strings.Split("a b c d e f o h", " "), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := StartsWithAny(tt.haystack, tt.needles); got != tt.want {
+				t.Errorf("StartsWithAny() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkEndsWithAny(t *testing.B) {
+	tests := []struct {
+		name     string
+		haystack string
+		needles  []string
+		want     bool
+	}{
+		{name: "extension", haystack: "photo.jpeg", needles: []string{".jpg", ".jpeg", ".png"}, want: true},
+		{name: "none", haystack: "photo.gif", needles: []string{".jpg", ".png"}, want: false},
+		{name: "repeated", haystack: "abab", needles: []string{"ab"}, want: true},
+		{name: "whole", haystack: "ab", needles: []string{"ab"}, want: true},
+		{name: "unicode", haystack: "北京市", needles: []string{"市"}, want: true},
+		{name: "empty needle", haystack: "abc", needles: []string{""}, want: false},
+		{name: "invalid bytes", haystack: "abc\xff", needles: []string{"\xff"}, want: true},
+		{name: "many", haystack: "gopher", needles: strings.Split("a b c d e f g r", " "), want: true},
+		{name: "many none", haystack: "gopher", needles: strings.Split("a b c d e f g h", " "), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := EndsWithAny(tt.haystack, tt.needles); got != tt.want {
+				t.Errorf("EndsWithAny() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkStrtr(t *testing.B) {
+	// every run of a is a key up to 2000 long, each position ends a match of every shorter key
+	runs := map[string]string{}
+	for i := 1; i <= 2000; i++ {
+		runs[strings.Repeat("a", i)] = "x"
+	}
+	tests := []struct {
+		name         string
+		subject      string
+		replacements map[string]string
+		want         string
+	}{
+		{name: "swap", subject: "Hi all, I said hello", replacements: map[string]string{"Hi": "Hello", "hello": "hi"}, want: "Hello all, I said hi"},
+		{name: "longest first", subject: "abc", replacements: map[string]string{"a": "1", "ab": "2", "abc": "3"}, want: "3"},
+		{name: "overlapping keys", subject: "ab bc", replacements: map[string]string{"ab": "x", "bc": "y", "b": "z"}, want: "x y"},
+		{name: "leftmost", subject: "abcd", replacements: map[string]string{"bcd": "x", "ab": "y"}, want: "ycd"},
+		{name: "not rescanned", subject: "a", replacements: map[string]string{"a": "b", "b": "c"}, want: "b"},
+		{name: "empty key", subject: "abc", replacements: map[string]string{"": "x", "b": ""}, want: "ac"},
+		{name: "unicode", subject: "你好，世界", replacements: map[string]string{"世界": "world", "你好": "hello"}, want: "hello，world"},
+		{name: "no match", subject: "abc", replacements: map[string]string{"x": "y"}, want: "abc"},
+		{name: "failure link", subject: "aab", replacements: map[string]string{"ab": "x", "aac": "y"}, want: "ax"},
+		{name: "after a pending match", subject: "abcy", replacements: map[string]string{"ab": "x", "c": "z", "abcx": "y"}, want: "xzy"},
+		{name: "leftmost found later", subject: "abcd", replacements: map[string]string{"bc": "x", "abcd": "y"}, want: "y"},
+		{name: "many overlapping keys", subject: strings.Repeat("a", 100001), replacements: runs, want: strings.Repeat("x", 51)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			for i := 0; i < 10; i++ {
+				if got := Strtr(tt.subject, tt.replacements); got != tt.want {
+					t.Fatalf("Strtr() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkACMatcher(t *testing.B) {
+	tests := []struct {
+		name     string
+		haystack string
+		needles  []string
+		fold     bool
+		want     []acMatch
+	}{
+		{name: "nested", haystack: "ushers", needles: []string{"he", "she", "his", "hers"}, want: []acMatch{
+			{needle: 1, start: 1, end: 4}, {needle: 0, start: 2, end: 4}, {needle: 3, start: 2, end: 6},
+		}},
+		{name: "fold offsets", haystack: "xSTRAẞE", needles: []string{"straße"}, fold: true, want: []acMatch{
+			{needle: 0, start: 1, end: 9},
+		}},
+		{name: "fold duplicates", haystack: "Go", needles: []string{"go", "GO"}, fold: true, want: []acMatch{
+			{needle: 0, start: 0, end: 2},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			var got []acMatch
+			newACMatcher(tt.needles, tt.fold).scan(tt.haystack, func(match acMatch) bool {
+				got = append(got, match)
+				return true
+			})
+			if len(got) != len(tt.want) {
+				t.Fatalf("scan() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("scan() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// strtrNaive replaces the longest key at each position by trying every key there.
+func strtrNaive(subject string, replacements map[string]string) string {
+	var builder strings.Builder
+	for i := 0; i < len(subject); {
+		longest := ""
+		for key := range replacements {
+			if len(key) > len(longest) && strings.HasPrefix(subject[i:], key) {
+				longest = key
+			}
+		}
+		if longest == "" {
+			builder.WriteByte(subject[i])
+			i++
+			continue
+		}
+		builder.WriteString(replacements[longest])
+		i += len(longest)
+	}
+	return builder.String()
+}
+
+func FuzzContainsAny(f *testing.F) {
+	f.Add("ushers", "he", "she", "hers", false)
+	f.Add("STRAẞE", "straße", "x", "", true)
+	f.Add("\xff\xfe", "\xfe", "\xef\xbf\xbd", "a", false)
+	f.Add("00", "0", "000", "", false)
+	f.Fuzz(func(t *testing.T, haystack string, a string, b string, c string, fold bool) {
+		needles := []string{a, b, c}
+		any, all := false, true
+		for _, needle := range needles {
+			found := needle != "" && strings.Contains(haystack, needle)
+			if fold {
+				found = ContainsFold(haystack, needle)
+			}
+			any = any || found
+			all = all && found
+		}
+		if got := ContainsAny(haystack, needles, fold); got != any {
+			t.Fatalf("ContainsAny(%q, %q, %v) = %v, want %v", haystack, needles, fold, got, any)
+		}
+		if got := ContainsAll(haystack, needles, fold); got != all {
+			t.Fatalf("ContainsAll(%q, %q, %v) = %v, want %v", haystack, needles, fold, got, all)
+		}
+		if !fold {
+			replacements := map[string]string{a: "1", b: "2", c: "3"}
+			delete(replacements, "")
+			if got, want := Strtr(haystack, replacements), strtrNaive(haystack, replacements); got != want {
+				t.Fatalf("Strtr(%q, %q) = %q, want %q", haystack, replacements, got, want)
+			}
+		}
+	})
+}
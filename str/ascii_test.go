@@ -0,0 +1,96 @@
+package str
+
+import (
+	"testing"
+)
+
+func BenchmarkAscii(t *testing.B) {
+	type args struct {
+		value    string
+		language string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "ascii", args: args{value: "hello world", language: "en"}, want: "hello world"},
+		{name: "latin", args: args{value: "Crème Brûlée à la mode", language: "en"}, want: "Creme Brulee a la mode"},
+		{name: "ligature", args: args{value: "Æsir Œuvre Straße", language: "en"}, want: "AEsir OEuvre Strasse"},
+		{name: "german", args: args{value: "Müller Größe", language: "de"}, want: "Mueller Groesse"},
+		{name: "german default", args: args{value: "Müller", language: ""}, want: "Muller"},
+		{name: "danish", args: args{value: "Blåbærsyltetøj", language: "da"}, want: "Blaabaersyltetoej"},
+		{name: "russian", args: args{value: "Привет, мир", language: "ru"}, want: "Privet, mir"},
+		{name: "bulgarian", args: args{value: "щастие", language: "bg"}, want: "shtastie"},
+		{name: "greek", args: args{value: "Καλημέρα κόσμε", language: "el"}, want: "Kalimera kosme"},
+		{name: "symbols", args: args{value: "“quoted” – 5 €", language: "en"}, want: `"quoted" - 5 EUR`},
+		{name: "unknown removed", args: args{value: "a中b", language: "en"}, want: "ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Ascii(tt.args.value, tt.args.language); got != tt.want {
+				t.Errorf("Ascii() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSlug(t *testing.B) {
+	type args struct {
+		title      string
+		separator  string
+		language   string
+		dictionary map[string]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "latin",
+			args: args{title: "Crème Brûlée à la mode", separator: "-", language: "en"},
+			want: "creme-brulee-a-la-mode",
+		}, {
+			name: "collapse separators",
+			args: args{title: "  Hello -- World__again!  ", separator: "-", language: "en"},
+			want: "hello-world-again",
+		}, {
+			name: "underscore",
+			args: args{title: "Hello World-again", separator: "_", language: "en"},
+			want: "hello_world_again",
+		}, {
+			name: "dictionary default",
+			args: args{title: "tom@example & jerry", separator: "-", language: "en"},
+			want: "tom-at-example-and-jerry",
+		}, {
+			name: "dictionary overlapping keys",
+			args: args{title: "a@@b@c", separator: "-", language: "en",
+				dictionary: map[string]string{"@": "at", "@@": "double", "@@@": "triple"}},
+			want: "a-double-b-at-c",
+		}, {
+			name: "dictionary custom",
+			args: args{title: "1+1", separator: "-", language: "en", dictionary: map[string]string{"+": "plus"}},
+			want: "1-plus-1",
+		}, {
+			name: "cyrillic",
+			args: args{title: "Привет мир", separator: "-", language: "ru"},
+			want: "privet-mir",
+		}, {
+			name: "greek",
+			args: args{title: "Γειά σου Κόσμε", separator: "-", language: "el"},
+			want: "geia-soy-kosme",
+		}, {
+			name: "keep unicode",
+			args: args{title: "你好 世界", separator: "-", language: ""},
+			want: "你好-世界",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Slug(tt.args.title, tt.args.separator, tt.args.language, tt.args.dictionary); got != tt.want {
+				t.Errorf("Slug() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package str
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// caseCacheLimit the number of entries a case cache keeps before it starts over.
+const caseCacheLimit = 10000
+
+// caseCache
+// @Description: memoizes case conversions of identifiers, which are usually converted again and again.
+type caseCache struct {
+	mutex  sync.RWMutex
+	values map[string]string
+}
+
+// remember
+// @Description: Get the cached conversion of a key, or build and store it.
+// @receiver c
+// @param key
+// @param build
+// @return string
+func (c *caseCache) remember(key string, build func() string) string {
+	c.mutex.RLock()
+	response, ok := c.values[key]
+	c.mutex.RUnlock()
+	if ok {
+		return response
+	}
+	response = build()
+	c.mutex.Lock()
+	if c.values == nil || len(c.values) >= caseCacheLimit {
+		c.values = map[string]string{}
+	}
+	c.values[key] = response
+	c.mutex.Unlock()
+	return response
+}
+
+// flush
+// @Description: Drop every cached conversion.
+// @receiver c
+func (c *caseCache) flush() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.values = nil
+}
+
+var (
+	snakeCache  = &caseCache{}
+	camelCache  = &caseCache{}
+	studlyCache = &caseCache{}
+
+	acronymMutex sync.RWMutex
+	acronyms     = map[string]bool{}
+)
+
+func init() {
+	RegisterAcronyms(
+		"ACL", "API", "ASCII", "CPU", "CSS", "CSV", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID",
+		"IP", "JSON", "JWT", "QPS", "RAM", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP",
+		"UI", "UID", "UUID", "URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS", "YAML",
+	)
+}
+
+// RegisterAcronyms
+// @Description: Register words that Studly, Camel and Headline write in upper case, such as ID in UserID.
+// @param words
+func RegisterAcronyms(words ...string) {
+	acronymMutex.Lock()
+	for _, word := range words {
+		acronyms[strings.ToUpper(word)] = true
+	}
+	acronymMutex.Unlock()
+	FlushCache()
+}
+
+// ForgetAcronyms
+// @Description: Remove words from the acronym list.
+// @param words
+func ForgetAcronyms(words ...string) {
+	acronymMutex.Lock()
+	for _, word := range words {
+		delete(acronyms, strings.ToUpper(word))
+	}
+	acronymMutex.Unlock()
+	FlushCache()
+}
+
+// IsAcronym
+// @Description: Determine if a word is in the acronym list, case insensitively.
+// @param word
+// @return bool
+func IsAcronym(word string) bool {
+	acronymMutex.RLock()
+	defer acronymMutex.RUnlock()
+	return acronyms[strings.ToUpper(word)]
+}
+
+// FlushCache
+// @Description: Drop the memoized Snake, Kebab, Camel and Studly conversions.
+func FlushCache() {
+	snakeCache.flush()
+	camelCache.flush()
+	studlyCache.flush()
+}
+
+// isWordUpper
+// @Description: the rune starts a new word when it follows a lower case letter.
+// @param r
+// @return bool
+func isWordUpper(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsTitle(r)
+}
+
+// isWordLower
+// @Description: lower case and caseless letters, and marks that belong to the letter before them.
+// @param r
+// @return bool
+func isWordLower(r rune) bool {
+	return (unicode.IsLetter(r) && !isWordUpper(r)) || unicode.IsMark(r)
+}
+
+// SplitWords
+// @Description: Split an identifier or a sentence into its words. Words are separated by anything that
+// is not a letter, digit or mark, by a lower case letter or digit followed by an upper case letter
+// (userID, utf8Decode), and by the last letter of an upper case run followed by lower case (HTTPServer).
+// A plural s after an acronym stays with it (URLs).
+// @param value
+// @return response
+func SplitWords(value string) (response []string) {
+	return splitWords(value, false)
+}
+
+// splitWords
+// @Description: Split words as SplitWords does, optionally keeping punctuation inside the words so that
+// case conversions only drop spaces, dashes and underscores (user.name stays user.name).
+// @param value
+// @param keepPunctuation
+// @return response
+func splitWords(value string, keepPunctuation bool) (response []string) {
+	runes := []rune(value)
+	start := -1
+	for i, r := range runes {
+		separator := !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+		if keepPunctuation {
+			separator = unicode.IsSpace(r) || r == '-' || r == '_'
+		}
+		if separator {
+			if start >= 0 {
+				response = append(response, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+		if !isWordUpper(r) {
+			continue
+		}
+		prev := runes[i-1]
+		boundary := isWordLower(prev) || unicode.IsDigit(prev)
+		if isWordUpper(prev) && i+1 < len(runes) && isWordLower(runes[i+1]) {
+			plural := runes[i+1] == 's' && (i+2 == len(runes) || !isWordLower(runes[i+2]))
+			boundary = !plural
+		}
+		if boundary {
+			response = append(response, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start >= 0 {
+		response = append(response, string(runes[start:]))
+	}
+	return response
+}
+
+// studlyWord
+// @Description: Write an acronym in upper case, keeping the s of a plural acronym (URLs), and any other
+// word with only its first letter in upper case.
+// @param word
+// @return string
+func studlyWord(word string) string {
+	if upper := strings.ToUpper(word); IsAcronym(upper) {
+		return upper
+	}
+	// a plural acronym such as URLs keeps its lower case s
+	if stem := strings.TrimSuffix(word, "s"); stem != word && stem != "" && IsAcronym(stem) {
+		return strings.ToUpper(stem) + "s"
+	}
+	return UcFirst(strings.ToLower(word))
+}
@@ -0,0 +1,110 @@
+package str
+
+import (
+	"reflect"
+	"testing"
+)
+
+func BenchmarkSplitWords(t *testing.B) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "acronym run", value: "HTTPServerID", want: []string{"HTTP", "Server", "ID"}},
+		{name: "digits", value: "utf8Decode", want: []string{"utf8", "Decode"}},
+		{name: "separators", value: "  user_id-list  name", want: []string{"user", "id", "list", "name"}},
+		{name: "plural acronym", value: "parseURLs", want: []string{"parse", "URLs"}},
+		{name: "unicode", value: "ärgerÜberÉté", want: []string{"ärger", "Über", "Été"}},
+		{name: "caseless", value: "中文_字符", want: []string{"中文", "字符"}},
+		{name: "combining mark", value: "caféBar", want: []string{"café", "Bar"}},
+		{name: "empty", value: "__", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := SplitWords(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitWords() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkCaseConversion(t *testing.B) {
+	tests := []struct {
+		name    string
+		convert func(string) string
+		value   string
+		want    string
+	}{
+		{name: "snake acronyms", convert: Snake, value: "HTTPServerID", want: "http_server_id"},
+		{name: "snake digits", convert: Snake, value: "utf8Decode", want: "utf8_decode"},
+		{name: "snake unicode", convert: Snake, value: "ÄrgerÜber", want: "ärger_über"},
+		{name: "kebab", convert: Kebab, value: "XMLHttpRequest", want: "xml-http-request"},
+		{name: "studly id", convert: Studly, value: "user_id", want: "UserID"},
+		{name: "studly acronyms", convert: Studly, value: "xml_http_request", want: "XMLHTTPRequest"},
+		{name: "studly digits", convert: Studly, value: "utf8_decode", want: "UTF8Decode"},
+		{name: "camel", convert: Camel, value: "user_id", want: "userID"},
+		{name: "camel leading acronym", convert: Camel, value: "HTTPServer", want: "httpServer"},
+		{name: "headline", convert: Headline, value: "user_url_id", want: "User URL ID"},
+		{name: "studly plural acronym", convert: Studly, value: "URLs", want: "URLs"},
+		{name: "studly plural acronym suffix", convert: Studly, value: "parseURLs", want: "ParseURLs"},
+		{name: "studly plural snake", convert: Studly, value: "user_ids", want: "UserIDs"},
+		{name: "studly plain plural", convert: Studly, value: "bus_stops", want: "BusStops"},
+		{name: "camel plural acronym", convert: Camel, value: "parse_urls", want: "parseURLs"},
+		{name: "headline plural acronym", convert: Headline, value: "URLs", want: "URLs"},
+		{name: "headline plural acronyms", convert: Headline, value: "user_ids_and_urls", want: "User IDs And URLs"},
+		{name: "snake punctuation", convert: Snake, value: "user.name", want: "user.name"},
+		{name: "kebab punctuation", convert: Kebab, value: "Hello, World!", want: "hello,-world!"},
+		{name: "headline punctuation", convert: Headline, value: "user.name", want: "User.name"},
+		{name: "studly punctuation", convert: Studly, value: "user.name", want: "User.name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := tt.convert(tt.value); got != tt.want {
+				t.Errorf("convert(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkRegisterAcronyms(t *testing.B) {
+	t.Run("register and forget", func(t *testing.B) {
+		if got := Studly("graphql_api"); got != "GraphqlAPI" {
+			t.Errorf("Studly() = %v, want %v", got, "GraphqlAPI")
+		}
+		RegisterAcronyms("graphql")
+		defer ForgetAcronyms("GraphQL")
+		if !IsAcronym("GraphQL") {
+			t.Errorf("IsAcronym() = false, want true")
+		}
+		// the cached conversion is dropped when the list changes
+		if got := Studly("graphql_api"); got != "GRAPHQLAPI" {
+			t.Errorf("Studly() = %v, want %v", got, "GRAPHQLAPI")
+		}
+	})
+}
+
+func BenchmarkCaseCache(t *testing.B) {
+	t.Run("limit", func(t *testing.B) {
+		cache := &caseCache{}
+		for i := 0; i <= caseCacheLimit; i++ {
+			cache.remember(Random(12), func() string { return "" })
+		}
+		if len(cache.values) > caseCacheLimit {
+			t.Errorf("len(cache.values) = %v, want at most %v", len(cache.values), caseCacheLimit)
+		}
+	})
+	t.Run("memoized", func(t *testing.B) {
+		cache := &caseCache{}
+		calls := 0
+		for i := 0; i < 3; i++ {
+			cache.remember("key", func() string {
+				calls++
+				return "value"
+			})
+		}
+		if calls != 1 {
+			t.Errorf("calls = %v, want 1", calls)
+		}
+	})
+}
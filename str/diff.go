@@ -0,0 +1,433 @@
+package str
+
+import (
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DiffGranularity the unit Diff compares.
+type DiffGranularity int
+
+const (
+	DiffLines      DiffGranularity = iota // lines including their line break
+	DiffWords                             // runs of letters and digits, runs of white space and single symbols
+	DiffCharacters                        // user-perceived characters
+)
+
+// DiffOperation what a DiffEdit does to the text.
+type DiffOperation int
+
+const (
+	DiffEqual DiffOperation = iota
+	DiffInsert
+	DiffDelete
+)
+
+// String
+// @Description: Get the name of the operation.
+// @receiver o
+// @return string
+func (o DiffOperation) String() string {
+	switch o {
+	case DiffInsert:
+		return "insert"
+	case DiffDelete:
+		return "delete"
+	}
+	return "equal"
+}
+
+// DiffEdit
+// @Description: a piece of text kept, inserted or deleted.
+type DiffEdit struct {
+	Operation DiffOperation
+	Text      string
+}
+
+// diffTokens
+// @Description: Split a string into the units compared by Diff, the tokens join back to the value.
+// @param value
+// @param granularity
+// @return response
+func diffTokens(value string, granularity DiffGranularity) (response []string) {
+	if value == "" {
+		return nil
+	}
+	switch granularity {
+	case DiffLines:
+		response = strings.SplitAfter(value, "\n")
+		if response[len(response)-1] == "" {
+			response = response[:len(response)-1]
+		}
+		return response
+	case DiffCharacters:
+		return Graphemes(value)
+	}
+	class := func(r rune) int {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+			return 1
+		case unicode.IsSpace(r):
+			return 2
+		}
+		return 0
+	}
+	runes := []rune(value)
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		if i == len(runes) || class(runes[i]) == 0 || class(runes[i]) != class(runes[start]) {
+			response = append(response, string(runes[start:i]))
+			start = i
+		}
+	}
+	return response
+}
+
+// myers
+// @Description: Get the shortest edit script turning the tokens of a into the tokens of b, see
+// E. Myers, "An O(ND) Difference Algorithm and Its Variations".
+// @param a
+// @param b
+// @return response edits of single tokens, in order
+func myers(a []string, b []string) (response []DiffEdit) {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	// snapshots[d] holds v[-d..d] after round d, which the backtrack walks through in reverse
+	var snapshots [][]int
+	get := func(d int, k int) int {
+		return snapshots[d][k+d]
+	}
+	found := false
+	for d := 0; d <= n+m && !found; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+			}
+		}
+		snapshots = append(snapshots, append([]int(nil), v[offset-d:offset+d+1]...))
+	}
+	x, y := n, m
+	for d := len(snapshots) - 1; d > 0; d-- {
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && get(d-1, k-1) < get(d-1, k+1)) {
+			prevK = k + 1
+		}
+		prevX := get(d-1, prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			response = append(response, DiffEdit{Operation: DiffEqual, Text: a[x-1]})
+			x, y = x-1, y-1
+		}
+		if x == prevX {
+			response = append(response, DiffEdit{Operation: DiffInsert, Text: b[y-1]})
+		} else {
+			response = append(response, DiffEdit{Operation: DiffDelete, Text: a[x-1]})
+		}
+		x, y = prevX, prevY
+	}
+	for x > 0 && y > 0 {
+		response = append(response, DiffEdit{Operation: DiffEqual, Text: a[x-1]})
+		x, y = x-1, y-1
+	}
+	for i, j := 0, len(response)-1; i < j; i, j = i+1, j-1 {
+		response[i], response[j] = response[j], response[i]
+	}
+	return response
+}
+
+// Diff
+// @Description: Get the edits turning a into b with the Myers algorithm, neighbouring edits of the same
+// operation are merged.
+// @param a
+// @param b
+// @param granularity
+// @return response
+func Diff(a string, b string, granularity DiffGranularity) (response []DiffEdit) {
+	for _, edit := range myers(diffTokens(a, granularity), diffTokens(b, granularity)) {
+		if last := len(response) - 1; last >= 0 && response[last].Operation == edit.Operation {
+			response[last].Text += edit.Text
+			continue
+		}
+		response = append(response, edit)
+	}
+	return response
+}
+
+// DiffToHTML
+// @Description: Render edits inline, deleted text in <del> and inserted text in <ins>, all text is escaped with E.
+// @param edits
+// @return string
+func DiffToHTML(edits []DiffEdit) string {
+	var builder strings.Builder
+	for _, edit := range edits {
+		switch edit.Operation {
+		case DiffInsert:
+			builder.WriteString("<ins>" + E(edit.Text) + "</ins>")
+		case DiffDelete:
+			builder.WriteString("<del>" + E(edit.Text) + "</del>")
+		default:
+			builder.WriteString(E(edit.Text))
+		}
+	}
+	return builder.String()
+}
+
+// diffNoNewline the marker unified diffs put after a line without a line break.
+const diffNoNewline = "\\ No newline at end of file\n"
+
+// UnifiedDiff
+// @Description: Get the line differences between a and b as unified diff text, empty when they are equal.
+// @param a
+// @param b
+// @param fromName name shown on the --- line
+// @param toName name shown on the +++ line
+// @param context number of unchanged lines shown around every change
+// @return string
+func UnifiedDiff(a string, b string, fromName string, toName string, context int) string {
+	lines := myers(diffTokens(a, DiffLines), diffTokens(b, DiffLines))
+	var builder strings.Builder
+	writeLine := func(prefix string, line string) {
+		builder.WriteString(prefix + line)
+		if !strings.HasSuffix(line, "\n") {
+			builder.WriteString("\n" + diffNoNewline)
+		}
+	}
+	oldLine, newLine := 0, 0 // lines of a and b before lines[i]
+	for i := 0; i < len(lines); {
+		if lines[i].Operation == DiffEqual {
+			oldLine, newLine = oldLine+1, newLine+1
+			i++
+			continue
+		}
+		// a hunk starts context lines before the change and ends context lines after the last change
+		// that is followed by no more than twice the context of unchanged lines
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		lastChange := i
+		for j := i; j < len(lines); j++ {
+			if lines[j].Operation != DiffEqual {
+				lastChange = j
+			} else if j-lastChange > 2*context {
+				break
+			}
+		}
+		end := lastChange + 1 + context
+		if end > len(lines) {
+			end = len(lines)
+		}
+		oldStart, newStart := oldLine-(i-start), newLine-(i-start)
+		oldCount, newCount := 0, 0
+		for _, edit := range lines[start:end] {
+			if edit.Operation != DiffInsert {
+				oldCount++
+			}
+			if edit.Operation != DiffDelete {
+				newCount++
+			}
+		}
+		if builder.Len() == 0 {
+			builder.WriteString("--- " + fromName + "\n+++ " + toName + "\n")
+		}
+		builder.WriteString(fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount)))
+		for _, edit := range lines[start:end] {
+			switch edit.Operation {
+			case DiffInsert:
+				writeLine("+", edit.Text)
+			case DiffDelete:
+				writeLine("-", edit.Text)
+			default:
+				writeLine(" ", edit.Text)
+			}
+		}
+		for _, edit := range lines[i:end] {
+			if edit.Operation != DiffInsert {
+				oldLine++
+			}
+			if edit.Operation != DiffDelete {
+				newLine++
+			}
+		}
+		i = end
+	}
+	return builder.String()
+}
+
+// hunkRange
+// @Description: Format the start and length of a hunk, an empty range starts at the line before it.
+// @param start number of lines before the hunk
+// @param count
+// @return string
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return strconv.Itoa(start + 1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// diffHunk
+// @Description: a parsed hunk of a unified diff.
+type diffHunk struct {
+	oldStart int // index of the first old line
+	newStart int // index of the first new line
+	oldLines []string
+	newLines []string
+}
+
+// diffHunkHeader matches "@@ -1,3 +1,4 @@".
+var diffHunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// parsePatch
+// @Description: Parse the hunks of a unified diff, the file header lines are skipped.
+// @param patch
+// @return response
+// @return error a ParseError for a malformed hunk
+func parsePatch(patch string) (response []diffHunk, e error) {
+	lines := diffTokens(patch, DiffLines)
+	atoi := func(value string, whenEmpty int) int {
+		if value == "" {
+			return whenEmpty
+		}
+		number, _ := strconv.Atoi(value)
+		return number
+	}
+	for i := 0; i < len(lines); {
+		match := diffHunkHeader.FindStringSubmatch(lines[i])
+		if match == nil {
+			if strings.HasPrefix(lines[i], "@@") {
+				return nil, exceptions.NewParseError("malformed hunk header", i+1, 1)
+			}
+			i++
+			continue
+		}
+		hunk := diffHunk{}
+		oldCount, newCount := atoi(match[2], 1), atoi(match[4], 1)
+		hunk.oldStart, hunk.newStart = atoi(match[1], 0), atoi(match[3], 0)
+		if oldCount > 0 {
+			hunk.oldStart--
+		}
+		if newCount > 0 {
+			hunk.newStart--
+		}
+		i++
+		for len(hunk.oldLines) < oldCount || len(hunk.newLines) < newCount {
+			if i >= len(lines) || lines[i] == "" {
+				return nil, exceptions.NewParseError("hunk is shorter than its header", i+1, 1)
+			}
+			line := lines[i][1:]
+			switch lines[i][0] {
+			case ' ':
+				hunk.oldLines = append(hunk.oldLines, line)
+				hunk.newLines = append(hunk.newLines, line)
+			case '-':
+				hunk.oldLines = append(hunk.oldLines, line)
+			case '+':
+				hunk.newLines = append(hunk.newLines, line)
+			default:
+				return nil, exceptions.NewParseError(fmt.Sprintf("unexpected hunk line prefix %q", lines[i][0]), i+1, 1)
+			}
+			i++
+			if i < len(lines) && strings.TrimSuffix(lines[i], "\n") == strings.TrimSuffix(diffNoNewline, "\n") {
+				// the marker applies to the line before it, which may belong to either side
+				switch lines[i-1][0] {
+				case ' ':
+					hunk.oldLines[len(hunk.oldLines)-1] = strings.TrimSuffix(line, "\n")
+					hunk.newLines[len(hunk.newLines)-1] = strings.TrimSuffix(line, "\n")
+				case '-':
+					hunk.oldLines[len(hunk.oldLines)-1] = strings.TrimSuffix(line, "\n")
+				case '+':
+					hunk.newLines[len(hunk.newLines)-1] = strings.TrimSuffix(line, "\n")
+				}
+				i++
+			}
+		}
+		if len(hunk.oldLines) != oldCount || len(hunk.newLines) != newCount {
+			return nil, exceptions.NewParseError("hunk is longer than its header", i, 1)
+		}
+		response = append(response, hunk)
+	}
+	return response, nil
+}
+
+// applyHunks
+// @Description: Replace the from lines of every hunk with its to lines.
+// @param value
+// @param hunks
+// @param reverse apply the hunks from the new side to the old side
+// @return string
+// @return error an InvalidParamError when a hunk does not match the value
+func applyHunks(value string, hunks []diffHunk, reverse bool) (string, error) {
+	lines := diffTokens(value, DiffLines)
+	var builder strings.Builder
+	position := 0
+	for index, hunk := range hunks {
+		start, from, to := hunk.oldStart, hunk.oldLines, hunk.newLines
+		if reverse {
+			start, from, to = hunk.newStart, hunk.newLines, hunk.oldLines
+		}
+		if start < position || start+len(from) > len(lines) {
+			return "", exceptions.NewInvalidParamError(fmt.Sprintf("hunk %d does not apply at line %d", index+1, start+1))
+		}
+		for i, line := range from {
+			if lines[start+i] != line {
+				return "", exceptions.NewInvalidParamError(fmt.Sprintf("hunk %d does not apply at line %d", index+1, start+i+1))
+			}
+		}
+		builder.WriteString(strings.Join(lines[position:start], ""))
+		builder.WriteString(strings.Join(to, ""))
+		position = start + len(from)
+	}
+	builder.WriteString(strings.Join(lines[position:], ""))
+	return builder.String(), nil
+}
+
+// ApplyPatch
+// @Description: Apply a unified diff made by UnifiedDiff(a, b, ...) to a, giving b.
+// @param source
+// @param patch
+// @return string
+// @return error a ParseError for a malformed patch, an InvalidParamError when it does not match the source
+func ApplyPatch(source string, patch string) (string, error) {
+	hunks, e := parsePatch(patch)
+	if e != nil {
+		return "", e
+	}
+	return applyHunks(source, hunks, false)
+}
+
+// ReversePatch
+// @Description: Undo a unified diff made by UnifiedDiff(a, b, ...) on b, giving a, so stored diffs can
+// rebuild older versions.
+// @param target
+// @param patch
+// @return string
+// @return error a ParseError for a malformed patch, an InvalidParamError when it does not match the target
+func ReversePatch(target string, patch string) (string, error) {
+	hunks, e := parsePatch(patch)
+	if e != nil {
+		return "", e
+	}
+	return applyHunks(target, hunks, true)
+}
@@ -0,0 +1,235 @@
+package str
+
+import (
+	"errors"
+	"github.com/melodywen/supports/exceptions"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func BenchmarkDiff(t *testing.B) {
+	type args struct {
+		a           string
+		b           string
+		granularity DiffGranularity
+	}
+	tests := []struct {
+		name string
+		args args
+		want []DiffEdit
+	}{
+		{
+			name: "words",
+			args: args{a: "the quick brown fox", b: "the slow brown fox!", granularity: DiffWords},
+			want: []DiffEdit{
+				{DiffEqual, "the "}, {DiffDelete, "quick"}, {DiffInsert, "slow"},
+				{DiffEqual, " brown fox"}, {DiffInsert, "!"},
+			},
+		},
+		{
+			name: "lines",
+			args: args{a: "a\nb\nc\n", b: "a\nc\nd\n", granularity: DiffLines},
+			want: []DiffEdit{{DiffEqual, "a\n"}, {DiffDelete, "b\n"}, {DiffEqual, "c\n"}, {DiffInsert, "d\n"}},
+		},
+		{
+			name: "characters",
+			args: args{a: "cafés", b: "cafes", granularity: DiffCharacters},
+			want: []DiffEdit{{DiffEqual, "caf"}, {DiffDelete, "é"}, {DiffInsert, "e"}, {DiffEqual, "s"}},
+		},
+		{
+			name: "equal",
+			args: args{a: "same", b: "same", granularity: DiffCharacters},
+			want: []DiffEdit{{DiffEqual, "same"}},
+		},
+		{
+			name: "empty",
+			args: args{a: "", b: "", granularity: DiffLines},
+			want: nil,
+		},
+		{
+			name: "from empty",
+			args: args{a: "", b: "new", granularity: DiffWords},
+			want: []DiffEdit{{DiffInsert, "new"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Diff(tt.args.a, tt.args.b, tt.args.granularity); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkDiffMinimal(t *testing.B) {
+	t.Run("random", func(t *testing.B) {
+		random := rand.New(rand.NewSource(39))
+		word := func() string {
+			return string(rune('a' + random.Intn(3)))
+		}
+		for i := 0; i < 300; i++ {
+			var a, b strings.Builder
+			for j := random.Intn(12); j > 0; j-- {
+				a.WriteString(word())
+			}
+			for j := random.Intn(12); j > 0; j-- {
+				b.WriteString(word())
+			}
+			edits := Diff(a.String(), b.String(), DiffCharacters)
+			source, target, changed := "", "", 0
+			for _, edit := range edits {
+				if edit.Operation != DiffInsert {
+					source += edit.Text
+				}
+				if edit.Operation != DiffDelete {
+					target += edit.Text
+				}
+				if edit.Operation != DiffEqual {
+					changed += len(edit.Text)
+				}
+			}
+			if source != a.String() || target != b.String() {
+				t.Fatalf("Diff(%q, %q) = %v does not rebuild both sides", a.String(), b.String(), edits)
+			}
+			// a shortest edit script keeps a longest common subsequence
+			if want := len(a.String()) + len(b.String()) - 2*longestCommonSubsequence(a.String(), b.String()); changed != want {
+				t.Fatalf("Diff(%q, %q) = %v changes %d characters, want %d", a.String(), b.String(), edits, changed, want)
+			}
+		}
+	})
+}
+
+// longestCommonSubsequence
+// @Description: Get the length of the longest common subsequence of two ASCII strings.
+// @param a
+// @param b
+// @return int
+func longestCommonSubsequence(a string, b string) int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				lengths[i][j] = lengths[i-1][j-1] + 1
+			} else if lengths[i-1][j] > lengths[i][j-1] {
+				lengths[i][j] = lengths[i-1][j]
+			} else {
+				lengths[i][j] = lengths[i][j-1]
+			}
+		}
+	}
+	return lengths[len(a)][len(b)]
+}
+
+func BenchmarkDiffToHTML(t *testing.B) {
+	t.Run("escape", func(t *testing.B) {
+		got := DiffToHTML(Diff("a < b", "a > b", DiffWords))
+		want := "a <del>&lt;</del><ins>&gt;</ins> b"
+		if got != want {
+			t.Errorf("DiffToHTML() = %v, want %v", got, want)
+		}
+	})
+}
+
+func BenchmarkUnifiedDiff(t *testing.B) {
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		context int
+		want    string
+	}{
+		{
+			name:    "context",
+			a:       "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			b:       "1\n2\n3\nfour\n5\n6\n7\n8\n9\n",
+			context: 1,
+			want:    "--- old\n+++ new\n@@ -3,3 +3,3 @@\n 3\n-4\n+four\n 5\n",
+		},
+		{
+			name:    "two hunks",
+			a:       "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			b:       "one\n2\n3\n4\n5\n6\n7\n8\nnine\n",
+			context: 1,
+			want:    "--- old\n+++ new\n@@ -1,2 +1,2 @@\n-1\n+one\n 2\n@@ -8,2 +8,2 @@\n 8\n-9\n+nine\n",
+		},
+		{
+			name:    "no newline",
+			a:       "a\nb",
+			b:       "a\nb\n",
+			context: 3,
+			want:    "--- old\n+++ new\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n",
+		},
+		{
+			name:    "from empty",
+			a:       "",
+			b:       "a\n",
+			context: 3,
+			want:    "--- old\n+++ new\n@@ -0,0 +1 @@\n+a\n",
+		},
+		{
+			name:    "equal",
+			a:       "a\n",
+			b:       "a\n",
+			context: 3,
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			patch := UnifiedDiff(tt.a, tt.b, "old", "new", tt.context)
+			if patch != tt.want {
+				t.Errorf("UnifiedDiff() = %q, want %q", patch, tt.want)
+			}
+			if got, e := ApplyPatch(tt.a, patch); e != nil || got != tt.b {
+				t.Errorf("ApplyPatch() = %q, %v, want %q", got, e, tt.b)
+			}
+			if got, e := ReversePatch(tt.b, patch); e != nil || got != tt.a {
+				t.Errorf("ReversePatch() = %q, %v, want %q", got, e, tt.a)
+			}
+		})
+	}
+}
+
+func BenchmarkApplyPatch(t *testing.B) {
+	t.Run("round trip", func(t *testing.B) {
+		random := rand.New(rand.NewSource(39))
+		lines := []string{"alpha\n", "beta\n", "gamma\n", "delta", "\n"}
+		text := func() string {
+			var builder strings.Builder
+			for i := random.Intn(15); i > 0; i-- {
+				builder.WriteString(lines[random.Intn(len(lines))])
+			}
+			return builder.String()
+		}
+		for i := 0; i < 200; i++ {
+			a, b := text(), text()
+			patch := UnifiedDiff(a, b, "a", "b", random.Intn(3))
+			if got, e := ApplyPatch(a, patch); e != nil || got != b {
+				t.Fatalf("ApplyPatch(%q, %q) = %q, %v, want %q", a, patch, got, e, b)
+			}
+			if got, e := ReversePatch(b, patch); e != nil || got != a {
+				t.Fatalf("ReversePatch(%q, %q) = %q, %v, want %q", b, patch, got, e, a)
+			}
+		}
+	})
+	t.Run("mismatch", func(t *testing.B) {
+		patch := UnifiedDiff("a\nb\n", "a\nc\n", "old", "new", 1)
+		_, e := ApplyPatch("x\nb\n", patch)
+		var invalid *exceptions.InvalidParamError
+		if !errors.As(e, &invalid) {
+			t.Errorf("ApplyPatch() error = %v, want InvalidParamError", e)
+		}
+	})
+	t.Run("malformed", func(t *testing.B) {
+		_, e := ApplyPatch("a\n", "--- old\n+++ new\n@@ -1,2 +1,2 @@\n a\n")
+		var parse *exceptions.ParseError
+		if !errors.As(e, &parse) || parse.GetLine() != 5 {
+			t.Errorf("ApplyPatch() error = %v, want ParseError at line 5", e)
+		}
+	})
+}
@@ -0,0 +1,298 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// equalFoldRune
+// @Description: Determine if two runes are equal under Unicode simple case folding, as strings.EqualFold
+// compares them.
+// @param a
+// @param b
+// @return bool
+func equalFoldRune(a rune, b rune) bool {
+	if a == b {
+		return true
+	}
+	if a < utf8.RuneSelf && b < utf8.RuneSelf {
+		if 'A' <= a && a <= 'Z' {
+			a += 'a' - 'A'
+		}
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		return a == b
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixFold
+// @Description: Match a prefix against the start of a string under simple case folding. Folding maps
+// rune to rune but not byte to byte, ẞ is three bytes and ß two, so the length of the match in s is returned.
+// @param s
+// @param prefix
+// @return end the byte length of the matched prefix of s
+// @return ok
+func prefixFold(s string, prefix string) (end int, ok bool) {
+	for prefix != "" {
+		if end == len(s) {
+			return 0, false
+		}
+		r, size := utf8.DecodeRuneInString(s[end:])
+		p, prefixSize := utf8.DecodeRuneInString(prefix)
+		if !equalFoldRune(r, p) {
+			return 0, false
+		}
+		end += size
+		prefix = prefix[prefixSize:]
+	}
+	return end, true
+}
+
+// suffixFold
+// @Description: Match a suffix against the end of a string under simple case folding.
+// @param s
+// @param suffix
+// @return start the byte offset of the matched suffix of s
+// @return ok
+func suffixFold(s string, suffix string) (start int, ok bool) {
+	start = len(s)
+	for suffix != "" {
+		if start == 0 {
+			return 0, false
+		}
+		r, size := utf8.DecodeLastRuneInString(s[:start])
+		p, suffixSize := utf8.DecodeLastRuneInString(suffix)
+		if !equalFoldRune(r, p) {
+			return 0, false
+		}
+		start -= size
+		suffix = suffix[:len(suffix)-suffixSize]
+	}
+	return start, true
+}
+
+// indexFold
+// @Description: Find the first occurrence of substr in s under simple case folding, starting at a
+// character boundary.
+// @param s
+// @param substr must not be empty
+// @return start the byte offset of the match in s, -1 when there is none
+// @return end the byte offset after the match in s
+func indexFold(s string, substr string) (start int, end int) {
+	first, _ := utf8.DecodeRuneInString(substr)
+	for start < len(s) {
+		r, size := utf8.DecodeRuneInString(s[start:])
+		if equalFoldRune(r, first) {
+			if length, ok := prefixFold(s[start:], substr); ok {
+				return start, start + length
+			}
+		}
+		start += size
+	}
+	return -1, -1
+}
+
+// lastIndexFold
+// @Description: Find the last occurrence of substr in s under simple case folding.
+// @param s
+// @param substr must not be empty
+// @return start the byte offset of the match in s, -1 when there is none
+// @return end the byte offset after the match in s
+func lastIndexFold(s string, substr string) (start int, end int) {
+	start, end = -1, -1
+	for offset := 0; offset < len(s); {
+		index, length := indexFold(s[offset:], substr)
+		if index < 0 {
+			break
+		}
+		start, end = offset+index, offset+length
+		_, size := utf8.DecodeRuneInString(s[start:])
+		offset = start + size
+	}
+	return start, end
+}
+
+// ContainsFold
+// @Description: Determine if a given string contains a given substring, ignoring case under Unicode
+// simple case folding.
+// @param haystack
+// @param needles
+// @return bool
+func ContainsFold(haystack string, needles string) bool {
+	if needles == "" {
+		return false
+	}
+	index, _ := indexFold(haystack, needles)
+	return index >= 0
+}
+
+// StartsWithFold
+// @Description: Determine if a given string starts with a given substring, ignoring case.
+// @param haystack
+// @param needles
+// @return bool
+func StartsWithFold(haystack string, needles string) bool {
+	if needles == "" {
+		return false
+	}
+	_, ok := prefixFold(haystack, needles)
+	return ok
+}
+
+// EndsWithFold
+// @Description: Determine if a given string ends with a given substring, ignoring case.
+// @param haystack
+// @param needles
+// @return bool
+func EndsWithFold(haystack string, needles string) bool {
+	if needles == "" {
+		return false
+	}
+	_, ok := suffixFold(haystack, needles)
+	return ok
+}
+
+// AfterFold
+// @Description: Return the remainder of a string after the first occurrence of a given value, ignoring case.
+// @param subject
+// @param search
+// @return string
+func AfterFold(subject string, search string) string {
+	if search == "" {
+		return subject
+	}
+	index, end := indexFold(subject, search)
+	if index < 0 {
+		return subject
+	}
+	return strings.TrimLeft(subject[end:], " ")
+}
+
+// AfterLastFold
+// @Description: Return the remainder of a string after the last occurrence of a given value, ignoring case.
+// @param subject
+// @param search
+// @return string
+func AfterLastFold(subject string, search string) string {
+	if search == "" {
+		return subject
+	}
+	index, end := lastIndexFold(subject, search)
+	if index < 0 {
+		return subject
+	}
+	return strings.TrimLeft(subject[end:], " ")
+}
+
+// BeforeFold
+// @Description: Get the portion of a string before the first occurrence of a given value, ignoring case.
+// @param subject
+// @param search
+// @return string
+func BeforeFold(subject string, search string) string {
+	if search == "" {
+		return subject
+	}
+	index, _ := indexFold(subject, search)
+	if index < 0 {
+		return subject
+	}
+	return subject[:index]
+}
+
+// BeforeLastFold
+// @Description: Get the portion of a string before the last occurrence of a given value, ignoring case.
+// @param subject
+// @param search
+// @return string
+func BeforeLastFold(subject string, search string) string {
+	if search == "" {
+		return subject
+	}
+	index, _ := lastIndexFold(subject, search)
+	if index < 0 {
+		return subject
+	}
+	return subject[:index]
+}
+
+// replaceFold
+// @Description: Replace up to limit occurrences of a given value from the left, ignoring case.
+// @param search
+// @param replace
+// @param subject
+// @param limit -1 for every occurrence
+// @return string
+func replaceFold(search string, replace string, subject string, limit int) string {
+	if search == "" || limit == 0 {
+		return subject
+	}
+	var builder strings.Builder
+	for limit != 0 {
+		index, end := indexFold(subject, search)
+		if index < 0 {
+			break
+		}
+		builder.WriteString(subject[:index])
+		builder.WriteString(replace)
+		subject = subject[end:]
+		limit--
+	}
+	builder.WriteString(subject)
+	return builder.String()
+}
+
+// ReplaceFold
+// @Description: Replace every occurrence of a given value in the string, ignoring case. An empty search
+// leaves the subject unchanged.
+// @param search
+// @param replace
+// @param subject
+// @return string
+func ReplaceFold(search string, replace string, subject string) string {
+	return replaceFold(search, replace, subject, -1)
+}
+
+// ReplaceFirstFold
+// @Description: Replace the first occurrence of a given value in the string, ignoring case.
+// @param search
+// @param replace
+// @param subject
+// @return string
+func ReplaceFirstFold(search string, replace string, subject string) string {
+	return replaceFold(search, replace, subject, 1)
+}
+
+// ReplaceLastFold
+// @Description: Replace the last occurrence of a given value in the string, ignoring case.
+// @param search
+// @param replace
+// @param subject
+// @return string
+func ReplaceLastFold(search string, replace string, subject string) string {
+	if search == "" {
+		return subject
+	}
+	index, end := lastIndexFold(subject, search)
+	if index < 0 {
+		return subject
+	}
+	return subject[:index] + replace + subject[end:]
+}
+
+// RemoveFold
+// @Description: Remove any occurrence of the given string, ignoring case.
+// @param search
+// @param subject
+// @return string
+func RemoveFold(search string, subject string) string {
+	return replaceFold(search, "", subject, -1)
+}
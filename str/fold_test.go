@@ -0,0 +1,152 @@
+package str
+
+import (
+	"strings"
+	"testing"
+)
+
+func BenchmarkFold(t *testing.B) {
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{name: "contains", got: ContainsFold("Hello World", "WORLD"), want: true},
+		{name: "contains missing", got: ContainsFold("Hello World", "worlds"), want: false},
+		{name: "contains empty", got: ContainsFold("Hello", ""), want: false},
+		{name: "contains kelvin", got: ContainsFold("273 K", "k"), want: true},
+		{name: "contains long s", got: ContainsFold("ſtraße", "STRASSE"), want: false},
+		{name: "contains sharp s", got: ContainsFold("STRAẞE", "straße"), want: true},
+		{name: "starts with", got: StartsWithFold("Golang", "GO"), want: true},
+		{name: "starts with longer", got: StartsWithFold("Go", "gopher"), want: false},
+		{name: "starts with greek", got: StartsWithFold("ΣΊΣΥΦΟΣ", "σίσ"), want: true},
+		{name: "ends with", got: EndsWithFold("photo.JPG", ".jpg"), want: true},
+		{name: "ends with whole", got: EndsWithFold("ABC", "abc"), want: true},
+		{name: "ends with repeated", got: EndsWithFold("abAB", "ab"), want: true},
+		{name: "ends with empty", got: EndsWithFold("abc", ""), want: false},
+		{name: "after", got: AfterFold("User: Tom", "user:"), want: "Tom"},
+		{name: "after missing", got: AfterFold("User: Tom", "name"), want: "User: Tom"},
+		{name: "after sharp s", got: AfterFold("GROẞE Straße", "große"), want: "Straße"},
+		{name: "after last", got: AfterLastFold("a/B/c", "b/"), want: "c"},
+		{name: "after last overlapping", got: AfterLastFold("aAaX", "aa"), want: "X"},
+		{name: "before", got: BeforeFold("Visit İstanbul", "İSTANBUL"), want: "Visit "},
+		{name: "before sharp s", got: BeforeFold("xẞy", "ß"), want: "x"},
+		{name: "before last", got: BeforeLastFold("a.B.b", "b"), want: "a.B."},
+		{name: "replace", got: ReplaceFold("go", "Rust", "Go go GO"), want: "Rust Rust Rust"},
+		{name: "replace keeps the rest", got: ReplaceFold("ẞ", "ss", "Maẞ und maß!"), want: "Mass und mass!"},
+		{name: "replace empty", got: ReplaceFold("", "x", "abc"), want: "abc"},
+		{name: "replace first", got: ReplaceFirstFold("a", "-", "bAa"), want: "b-a"},
+		{name: "replace last", got: ReplaceLastFold("a", "-", "bAa"), want: "bA-"},
+		{name: "remove", got: RemoveFold("is", "thisAIsMyName"), want: "thAMyName"},
+		{name: "remove capital dotted i", got: RemoveFold("x", "İxİX"), want: "İİ"},
+		{name: "remove", got: Remove("ẞ", "aßbẞc", false), want: "abc"},
+		{name: "contains ignore case", got: Contains("ÅNGSTRÖM", "ångström", true), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if tt.got != tt.want {
+				t.Errorf("got %#v, want %#v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// foldBoundaries the byte offsets where the runes of a string start, and its length.
+func foldBoundaries(s string) []int {
+	var boundaries []int
+	for i := range s {
+		boundaries = append(boundaries, i)
+	}
+	return append(boundaries, len(s))
+}
+
+// addFoldSeeds adds inputs whose case forms have different byte lengths.
+func addFoldSeeds(f *testing.F) {
+	seeds := [][2]string{
+		{"Hello World", "WORLD"},
+		{"STRAẞE straße", "SSE"},
+		{"STRAẞE straße", "ß"},
+		{"İstanbul", "i"},
+		{"273 K", "k"},
+		{"ſ", "S"},
+		{"aAaA", "aa"},
+		{"\xff\xe2\x82", "\xfe"},
+		{"ΣΊΣΥΦΟΣ", "ς"},
+	}
+	for _, seed := range seeds {
+		f.Add(seed[0], seed[1])
+	}
+}
+
+func FuzzContainsFold(f *testing.F) {
+	addFoldSeeds(f)
+	f.Fuzz(func(t *testing.T, s string, substr string) {
+		if len(s) > 256 || substr == "" {
+			return
+		}
+		start, end := indexFold(s, substr)
+		boundaries := foldBoundaries(s)
+		first := -1
+		for _, i := range boundaries {
+			for _, j := range boundaries {
+				if j >= i && strings.EqualFold(s[i:j], substr) {
+					first = i
+					break
+				}
+			}
+			if first >= 0 {
+				break
+			}
+		}
+		if start != first {
+			t.Fatalf("indexFold(%q, %q) = %d, want %d", s, substr, start, first)
+		}
+		if start >= 0 && !strings.EqualFold(s[start:end], substr) {
+			t.Fatalf("indexFold(%q, %q) matched %q", s, substr, s[start:end])
+		}
+		if ContainsFold(s, substr) != (first >= 0) {
+			t.Fatalf("ContainsFold(%q, %q) = %v", s, substr, !(first >= 0))
+		}
+		if start, end := lastIndexFold(s, substr); start >= 0 && !strings.EqualFold(s[start:end], substr) {
+			t.Fatalf("lastIndexFold(%q, %q) matched %q", s, substr, s[start:end])
+		}
+		if replaced := ReplaceFold(substr, "", s); first >= 0 && len(replaced) >= len(s) {
+			t.Fatalf("ReplaceFold(%q, %q) removed nothing", substr, s)
+		}
+	})
+}
+
+func FuzzStartsWithFold(f *testing.F) {
+	addFoldSeeds(f)
+	f.Fuzz(func(t *testing.T, s string, prefix string) {
+		want := false
+		for _, i := range foldBoundaries(s) {
+			if prefix != "" && strings.EqualFold(s[:i], prefix) {
+				want = true
+				break
+			}
+		}
+		if got := StartsWithFold(s, prefix); got != want {
+			t.Fatalf("StartsWithFold(%q, %q) = %v, want %v", s, prefix, got, want)
+		}
+		if want && BeforeFold(s, prefix) != "" {
+			t.Fatalf("BeforeFold(%q, %q) = %q, want empty", s, prefix, BeforeFold(s, prefix))
+		}
+	})
+}
+
+func FuzzEndsWithFold(f *testing.F) {
+	addFoldSeeds(f)
+	f.Fuzz(func(t *testing.T, s string, suffix string) {
+		want := false
+		for _, i := range foldBoundaries(s) {
+			if suffix != "" && strings.EqualFold(s[i:], suffix) {
+				want = true
+				break
+			}
+		}
+		if got := EndsWithFold(s, suffix); got != want {
+			t.Fatalf("EndsWithFold(%q, %q) = %v, want %v", s, suffix, got, want)
+		}
+	})
+}
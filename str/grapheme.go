@@ -0,0 +1,291 @@
+package str
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// graphemeProperty
+// @Description: Grapheme_Cluster_Break property of a rune, see Unicode UAX #29.
+type graphemeProperty int
+
+const (
+	graphemeOther graphemeProperty = iota
+	graphemeCR
+	graphemeLF
+	graphemeControl
+	graphemeExtend
+	graphemeZWJ
+	graphemeRegionalIndicator
+	graphemePrepend
+	graphemeSpacingMark
+	graphemeL
+	graphemeV
+	graphemeT
+	graphemeLV
+	graphemeLVT
+	graphemePictographic
+)
+
+// graphemeExtendTable runes that extend the previous character besides the Mn and Me categories.
+var graphemeExtendTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x200c, Hi: 0x200c, Stride: 1},
+		{Lo: 0xff9e, Hi: 0xff9f, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1},
+		{Lo: 0xe0020, Hi: 0xe007f, Stride: 1},
+	},
+}
+
+// graphemePrependTable runes that attach to the following character.
+var graphemePrependTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0600, Hi: 0x0605, Stride: 1},
+		{Lo: 0x06dd, Hi: 0x06dd, Stride: 1},
+		{Lo: 0x070f, Hi: 0x070f, Stride: 1},
+		{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+		{Lo: 0x08e2, Hi: 0x08e2, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x110bd, Hi: 0x110bd, Stride: 1},
+		{Lo: 0x110cd, Hi: 0x110cd, Stride: 1},
+	},
+}
+
+// graphemePictographicTable Extended_Pictographic runes, the emoji that may be joined by ZWJ.
+var graphemePictographicTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x2388, Hi: 0x2388, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1f0ff, Stride: 1},
+		{Lo: 0x1f10d, Hi: 0x1f10f, Stride: 1},
+		{Lo: 0x1f12f, Hi: 0x1f12f, Stride: 1},
+		{Lo: 0x1f16c, Hi: 0x1f171, Stride: 1},
+		{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1ad, Hi: 0x1f1e5, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f20f, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f23c, Hi: 0x1f23f, Stride: 1},
+		{Lo: 0x1f249, Hi: 0x1f3fa, Stride: 1},
+		{Lo: 0x1f400, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f546, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f774, Hi: 0x1f77f, Stride: 1},
+		{Lo: 0x1f7d5, Hi: 0x1f7ff, Stride: 1},
+		{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+		{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+		{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+		{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+		{Lo: 0x1f8ae, Hi: 0x1f8ff, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+	},
+}
+
+// graphemePropertyOf
+// @Description: Get the Grapheme_Cluster_Break property of a rune.
+// @param r
+// @return graphemeProperty
+func graphemePropertyOf(r rune) graphemeProperty {
+	switch {
+	case r == '\r':
+		return graphemeCR
+	case r == '\n':
+		return graphemeLF
+	case r == 0x200d:
+		return graphemeZWJ
+	case r >= 0x1f1e6 && r <= 0x1f1ff:
+		return graphemeRegionalIndicator
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return graphemeLV
+		}
+		return graphemeLVT
+	case (r >= 0x1100 && r <= 0x115f) || (r >= 0xa960 && r <= 0xa97c):
+		return graphemeL
+	case (r >= 0x1160 && r <= 0x11a7) || (r >= 0xd7b0 && r <= 0xd7c6):
+		return graphemeV
+	case (r >= 0x11a8 && r <= 0x11ff) || (r >= 0xd7cb && r <= 0xd7fb):
+		return graphemeT
+	case unicode.Is(graphemePrependTable, r):
+		return graphemePrepend
+	case unicode.In(r, unicode.Mn, unicode.Me, graphemeExtendTable):
+		return graphemeExtend
+	case unicode.Is(unicode.Mc, r):
+		return graphemeSpacingMark
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Zl, unicode.Zp):
+		return graphemeControl
+	case unicode.Is(graphemePictographicTable, r):
+		return graphemePictographic
+	}
+	return graphemeOther
+}
+
+// graphemeBreak
+// @Description: Decide whether a grapheme cluster boundary sits between two runes.
+// @param prev property of the rune before the boundary
+// @param next property of the rune after the boundary
+// @param emoji the runes before the boundary end with Extended_Pictographic Extend* ZWJ
+// @param regionalCount number of consecutive regional indicators before the boundary
+// @return bool
+func graphemeBreak(prev, next graphemeProperty, emoji bool, regionalCount int) bool {
+	switch {
+	case prev == graphemeCR && next == graphemeLF:
+		return false
+	case prev == graphemeCR || prev == graphemeLF || prev == graphemeControl:
+		return true
+	case next == graphemeCR || next == graphemeLF || next == graphemeControl:
+		return true
+	case prev == graphemeL && (next == graphemeL || next == graphemeV || next == graphemeLV || next == graphemeLVT):
+		return false
+	case (prev == graphemeLV || prev == graphemeV) && (next == graphemeV || next == graphemeT):
+		return false
+	case (prev == graphemeLVT || prev == graphemeT) && next == graphemeT:
+		return false
+	case next == graphemeExtend || next == graphemeZWJ || next == graphemeSpacingMark:
+		return false
+	case prev == graphemePrepend:
+		return false
+	case emoji && next == graphemePictographic:
+		return false
+	case prev == graphemeRegionalIndicator && next == graphemeRegionalIndicator:
+		return regionalCount%2 == 0
+	}
+	return true
+}
+
+// graphemeOffsets
+// @Description: Get the byte offsets of every grapheme cluster boundary, including 0 and len(value).
+// @param value
+// @return response
+func graphemeOffsets(value string) (response []int) {
+	response = make([]int, 0, len(value)+1)
+	response = append(response, 0)
+	if value == "" {
+		return response
+	}
+	r, size := utf8.DecodeRuneInString(value)
+	prev := graphemePropertyOf(r)
+	pictographic := prev == graphemePictographic
+	emoji := false
+	regionalCount := 0
+	if prev == graphemeRegionalIndicator {
+		regionalCount = 1
+	}
+	for offset := size; offset < len(value); offset += size {
+		r, size = utf8.DecodeRuneInString(value[offset:])
+		next := graphemePropertyOf(r)
+		if graphemeBreak(prev, next, emoji, regionalCount) {
+			response = append(response, offset)
+		}
+		switch next {
+		case graphemeExtend:
+			emoji = false
+		case graphemeZWJ:
+			emoji = pictographic
+			pictographic = false
+		case graphemePictographic:
+			pictographic = true
+			emoji = false
+		default:
+			pictographic = false
+			emoji = false
+		}
+		if next == graphemeRegionalIndicator {
+			regionalCount++
+		} else {
+			regionalCount = 0
+		}
+		prev = next
+	}
+	return append(response, len(value))
+}
+
+// Graphemes
+// @Description: Split a string into user-perceived characters (grapheme clusters), so that
+// combining marks, flags and ZWJ emoji sequences are never split.
+// @param value
+// @return response
+func Graphemes(value string) (response []string) {
+	offsets := graphemeOffsets(value)
+	response = make([]string, len(offsets)-1)
+	for i := range response {
+		response[i] = value[offsets[i]:offsets[i+1]]
+	}
+	return response
+}
+
+// graphemeSlice
+// @Description: Get the portion of a string between two grapheme positions, positions are clamped.
+// @param value
+// @param offsets boundaries returned by graphemeOffsets
+// @param start
+// @param end
+// @return string
+func graphemeSlice(value string, offsets []int, start int, end int) string {
+	count := len(offsets) - 1
+	if start < 0 {
+		start = 0
+	}
+	if end > count {
+		end = count
+	}
+	if start >= end {
+		return ""
+	}
+	return value[offsets[start]:offsets[end]]
+}
+
+// repeatGraphemes
+// @Description: Repeat the pad string and cut it to exactly length grapheme clusters.
+// @param pad
+// @param length
+// @return string
+func repeatGraphemes(pad string, length int) string {
+	padGraphemes := Graphemes(pad)
+	if length <= 0 || len(padGraphemes) == 0 {
+		return ""
+	}
+	response := make([]byte, 0, len(pad)*(length/len(padGraphemes)+1))
+	for i := 0; i < length; i++ {
+		response = append(response, padGraphemes[i%len(padGraphemes)]...)
+	}
+	return string(response)
+}
@@ -0,0 +1,102 @@
+package str
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func BenchmarkGraphemes(t *testing.B) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "empty", value: "", want: []string{}},
+		{name: "ascii", value: "go", want: []string{"g", "o"}},
+		{name: "crlf", value: "a\r\nb", want: []string{"a", "\r\n", "b"}},
+		{name: "chinese", value: "中文", want: []string{"中", "文"}},
+		{name: "combining", value: "été", want: []string{"é", "t", "é"}},
+		{name: "flags", value: "🇨🇳🇺🇸🇯", want: []string{"🇨🇳", "🇺🇸", "🇯"}},
+		{name: "zwj family", value: "👨‍👩‍👧!", want: []string{"👨‍👩‍👧", "!"}},
+		{name: "skin tone", value: "👍🏽👍", want: []string{"👍🏽", "👍"}},
+		{name: "hangul jamo", value: "각가", want: []string{"각", "가"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Graphemes(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Graphemes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkUnicodeOffsets(t *testing.B) {
+	tests := []struct {
+		name string
+		got  func() string
+		want string
+	}{
+		{name: "Length", got: func() string { return strings.Repeat("x", Length("中文👨‍👩‍👧🇨🇳")) }, want: "xxxx"},
+		{name: "Substr", got: func() string { return Substr("你好👨‍👩‍👧世界", 1, 2) }, want: "好👨‍👩‍👧"},
+		{name: "Limit", got: func() string { return Limit("🇨🇳🇺🇸🇯🇵", 2, "...") }, want: "🇨🇳🇺🇸..."},
+		{name: "LimitBytes", got: func() string { return LimitBytes("中文abc", 5, "...") }, want: "中..."},
+		{name: "Mask", got: func() string { return Mask("张三丰先生", "＊", 1, 2) }, want: "张＊＊先生"},
+		{name: "PadLeft", got: func() string { return PadLeft("中", 3, "文字") }, want: "文字中"},
+		{name: "PadRight", got: func() string { return PadRight("中", 4, "😀") }, want: "中😀😀😀"},
+		{name: "PadBoth", got: func() string { return PadBoth("中", 4, "-") }, want: "-中--"},
+		{name: "SubstrReplace", got: func() string { return SubstrReplace("一二三", "+", 1, 1) }, want: "一+三"},
+		{name: "Excerpt", got: func() string {
+			return Excerpt("这是一个很长的句子", "很长", map[string]string{"radius": "1"})
+		}, want: "...个很长的..."},
+		{name: "UcFirst", got: func() string { return UcFirst("élan") }, want: "Élan"},
+		{name: "Reverse", got: func() string { return Reverse("été🇨🇳") }, want: "🇨🇳été"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := tt.got(); got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkValidUTF8(t *testing.B) {
+	pieces := []string{"a", "Z", " ", "中", "文", "é", "é", "🇨🇳", "👨‍👩‍👧", "👍🏽", "\r\n", "가", "ß", "-"}
+	random := rand.New(rand.NewSource(1))
+	randomString := func() string {
+		builder := strings.Builder{}
+		for i := random.Intn(12); i > 0; i-- {
+			builder.WriteString(pieces[random.Intn(len(pieces))])
+		}
+		return builder.String()
+	}
+	functions := map[string]func(value string, pad string, a int, b int) string{
+		"Substr":        func(value string, pad string, a int, b int) string { return Substr(value, a, b) },
+		"Limit":         func(value string, pad string, a int, b int) string { return Limit(value, a, pad) },
+		"LimitBytes":    func(value string, pad string, a int, b int) string { return LimitBytes(value, a, pad) },
+		"Mask":          func(value string, pad string, a int, b int) string { return Mask(value, pad, a, b) },
+		"PadLeft":       func(value string, pad string, a int, b int) string { return PadLeft(value, a, pad) },
+		"PadRight":      func(value string, pad string, a int, b int) string { return PadRight(value, a, pad) },
+		"PadBoth":       func(value string, pad string, a int, b int) string { return PadBoth(value, a, pad) },
+		"SubstrReplace": func(value string, pad string, a int, b int) string { return SubstrReplace(value, pad, a, b) },
+		"UcFirst":       func(value string, pad string, a int, b int) string { return UcFirst(value) },
+		"Reverse":       func(value string, pad string, a int, b int) string { return Reverse(value) },
+		"Excerpt": func(value string, pad string, a int, b int) string {
+			return Excerpt(value+pad, pad, map[string]string{"radius": "2"})
+		},
+	}
+	for name, function := range functions {
+		t.Run(name, func(t *testing.B) {
+			for i := 0; i < 500; i++ {
+				value, pad := randomString(), randomString()
+				a, b := random.Intn(20)-5, random.Intn(20)-5
+				if got := function(value, pad, a, b); !utf8.ValidString(got) {
+					t.Errorf("%s(%q, %q, %d, %d) = %q is not valid utf-8", name, value, pad, a, b, got)
+				}
+			}
+		})
+	}
+}
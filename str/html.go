@@ -0,0 +1,704 @@
+package str
+
+import (
+	"html"
+	"strconv"
+	"strings"
+)
+
+// htmlTokenKind the kinds of token the HTML tokenizer produces.
+type htmlTokenKind int
+
+const (
+	htmlText     htmlTokenKind = iota
+	htmlStartTag               // <name attr="value"> or <name/>
+	htmlEndTag                 // </name>
+	htmlComment                // comments, doctypes and processing instructions
+)
+
+// htmlAttribute
+// @Description: an attribute of a start tag.
+type htmlAttribute struct {
+	name  string // lower case
+	value string // entities decoded
+}
+
+// htmlToken
+// @Description: a token of an HTML document.
+type htmlToken struct {
+	kind        htmlTokenKind
+	raw         string // the token as written
+	name        string // lower case tag name of a start or end tag
+	attributes  []htmlAttribute
+	selfClosing bool
+}
+
+// attribute
+// @Description: Get the value of an attribute of a start tag.
+// @receiver t
+// @param name lower case
+// @return string
+// @return bool
+func (t htmlToken) attribute(name string) (string, bool) {
+	for _, attribute := range t.attributes {
+		if attribute.name == name {
+			return attribute.value, true
+		}
+	}
+	return "", false
+}
+
+// htmlRawTextTags elements whose content runs to their end tag as text, a < inside them opens no tag.
+var htmlRawTextTags = map[string]bool{
+	"script": true, "style": true, "textarea": true, "title": true, "xmp": true, "iframe": true,
+	"noembed": true, "noframes": true,
+}
+
+// htmlVoidTags elements that have no content and no end tag.
+var htmlVoidTags = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true, "input": true,
+	"link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+}
+
+// htmlTokenizer
+// @Description: splits HTML into text, tags and comments the way browsers do for well formed input, and
+// recovers from broken input without losing text: a < that opens no tag is text.
+type htmlTokenizer struct {
+	input   string
+	pos     int
+	rawText string // the raw text element the tokenizer is inside
+}
+
+// isASCIILetter
+// @Description: Determine if a byte is an ASCII letter.
+// @param c
+// @return bool
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// isHTMLSpace
+// @Description: Determine if a byte is HTML white space.
+// @param c
+// @return bool
+func isHTMLSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// opensTag
+// @Description: Determine if the < at a position opens a tag or a comment.
+// @receiver z
+// @param pos
+// @return bool
+func (z *htmlTokenizer) opensTag(pos int) bool {
+	if pos+1 >= len(z.input) {
+		return false
+	}
+	switch c := z.input[pos+1]; {
+	case isASCIILetter(c), c == '!', c == '?':
+		return true
+	case c == '/':
+		return pos+2 < len(z.input) && (isASCIILetter(z.input[pos+2]) || z.input[pos+2] == '>')
+	}
+	return false
+}
+
+// indexEndTag
+// @Description: Find the end tag of a raw text element, its name is matched ignoring case.
+// @param value
+// @param name lower case
+// @return int the index of the end tag, -1 when there is none
+func indexEndTag(value string, name string) int {
+	for i := 0; ; {
+		index := strings.Index(value[i:], "</")
+		if index < 0 {
+			return -1
+		}
+		i += index
+		end := i + 2 + len(name)
+		if end <= len(value) && strings.EqualFold(value[i+2:end], name) &&
+			(end == len(value) || isHTMLSpace(value[end]) || value[end] == '/' || value[end] == '>') {
+			return i
+		}
+		i += 2
+	}
+}
+
+// next
+// @Description: Get the next token.
+// @receiver z
+// @return token
+// @return ok false at the end of the input
+func (z *htmlTokenizer) next() (token htmlToken, ok bool) {
+	if z.pos >= len(z.input) {
+		return token, false
+	}
+	start := z.pos
+	if z.rawText != "" {
+		end := indexEndTag(z.input[z.pos:], z.rawText)
+		z.rawText = ""
+		if end < 0 {
+			end = len(z.input) - z.pos
+		}
+		if end > 0 {
+			z.pos += end
+			return htmlToken{kind: htmlText, raw: z.input[start:z.pos]}, true
+		}
+	}
+	if z.input[z.pos] != '<' || !z.opensTag(z.pos) {
+		for z.pos++; z.pos < len(z.input) && !(z.input[z.pos] == '<' && z.opensTag(z.pos)); z.pos++ {
+		}
+		return htmlToken{kind: htmlText, raw: z.input[start:z.pos]}, true
+	}
+	switch c := z.input[z.pos+1]; {
+	case strings.HasPrefix(z.input[z.pos:], "<!--"):
+		end := strings.Index(z.input[z.pos+4:], "-->")
+		if end < 0 {
+			z.pos = len(z.input)
+		} else {
+			z.pos += 4 + end + 3
+		}
+		return htmlToken{kind: htmlComment, raw: z.input[start:z.pos]}, true
+	case c == '!', c == '?', c == '/' && z.input[z.pos+2] == '>':
+		end := strings.IndexByte(z.input[z.pos:], '>')
+		if end < 0 {
+			z.pos = len(z.input)
+		} else {
+			z.pos += end + 1
+		}
+		return htmlToken{kind: htmlComment, raw: z.input[start:z.pos]}, true
+	case c == '/':
+		token.kind = htmlEndTag
+		z.pos += 2
+	default:
+		token.kind = htmlStartTag
+		z.pos++
+	}
+	nameStart := z.pos
+	for z.pos < len(z.input) && !isHTMLSpace(z.input[z.pos]) && z.input[z.pos] != '/' && z.input[z.pos] != '>' {
+		z.pos++
+	}
+	token.name = strings.ToLower(z.input[nameStart:z.pos])
+	z.attributes(&token)
+	token.raw = z.input[start:z.pos]
+	if token.kind == htmlStartTag && htmlRawTextTags[token.name] && !token.selfClosing {
+		z.rawText = token.name
+	}
+	return token, true
+}
+
+// attributes
+// @Description: Read the attributes of a tag up to and including its >.
+// @receiver z
+// @param token
+func (z *htmlTokenizer) attributes(token *htmlToken) {
+	for z.pos < len(z.input) {
+		c := z.input[z.pos]
+		switch {
+		case c == '>':
+			z.pos++
+			return
+		case c == '/':
+			z.pos++
+			if z.pos < len(z.input) && z.input[z.pos] == '>' {
+				token.selfClosing = true
+			}
+			continue
+		case isHTMLSpace(c):
+			z.pos++
+			continue
+		}
+		// the first character of a name may be =, as browsers read it
+		nameStart := z.pos
+		for z.pos++; z.pos < len(z.input); z.pos++ {
+			if c := z.input[z.pos]; isHTMLSpace(c) || c == '/' || c == '>' || c == '=' {
+				break
+			}
+		}
+		attribute := htmlAttribute{name: strings.ToLower(z.input[nameStart:z.pos])}
+		z.skipSpace()
+		if z.pos < len(z.input) && z.input[z.pos] == '=' {
+			z.pos++
+			z.skipSpace()
+			attribute.value = html.UnescapeString(z.attributeValue())
+		}
+		token.attributes = append(token.attributes, attribute)
+	}
+}
+
+// skipSpace
+// @Description: Move past white space.
+// @receiver z
+func (z *htmlTokenizer) skipSpace() {
+	for z.pos < len(z.input) && isHTMLSpace(z.input[z.pos]) {
+		z.pos++
+	}
+}
+
+// attributeValue
+// @Description: Read a quoted or unquoted attribute value.
+// @receiver z
+// @return string the value as written, without quotes
+func (z *htmlTokenizer) attributeValue() string {
+	if z.pos >= len(z.input) {
+		return ""
+	}
+	if quote := z.input[z.pos]; quote == '"' || quote == '\'' {
+		end := strings.IndexByte(z.input[z.pos+1:], quote)
+		if end < 0 {
+			value := z.input[z.pos+1:]
+			z.pos = len(z.input)
+			return value
+		}
+		value := z.input[z.pos+1 : z.pos+1+end]
+		z.pos += end + 2
+		return value
+	}
+	start := z.pos
+	for z.pos < len(z.input) && !isHTMLSpace(z.input[z.pos]) && z.input[z.pos] != '>' {
+		z.pos++
+	}
+	return z.input[start:z.pos]
+}
+
+// Unescape
+// @Description: Decode the HTML entities of a string, named (&amp;) and numeric (&#39; &#x27;) alike.
+// @param value
+// @return string
+func Unescape(value string) string {
+	return html.UnescapeString(value)
+}
+
+// StripTags
+// @Description: Remove the HTML tags and comments of a string, keeping its text as written. The content of
+// script, style, iframe and the other raw text elements is removed with them unless they are allowed, the
+// content of textarea and title is kept with its tags stripped as well.
+// @param value
+// @param allowedTags tags to keep, written as b or <b>
+// @return string
+func StripTags(value string, allowedTags []string) string {
+	allowed := map[string]bool{}
+	for _, tag := range allowedTags {
+		allowed[strings.ToLower(strings.Trim(tag, "</>"))] = true
+	}
+	var builder strings.Builder
+	// rawText the element whose content the tokenizer returns next as a single text
+	rawText := ""
+	z := htmlTokenizer{input: value}
+	for token, ok := z.next(); ok; token, ok = z.next() {
+		switch token.kind {
+		case htmlText:
+			switch rawText {
+			case "":
+				builder.WriteString(token.raw)
+			case "textarea", "title":
+				// the content is shorter than value, so this ends
+				builder.WriteString(StripTags(token.raw, allowedTags))
+			}
+		case htmlStartTag, htmlEndTag:
+			if allowed[token.name] {
+				builder.WriteString(token.raw)
+			} else if token.kind == htmlStartTag && htmlRawTextTags[token.name] && !token.selfClosing {
+				rawText = token.name
+				continue
+			}
+		}
+		rawText = ""
+	}
+	return builder.String()
+}
+
+// SanitizeOptions
+// @Description: what Sanitize keeps of untrusted HTML.
+type SanitizeOptions struct {
+	Tags     map[string][]string // allowed tag => its allowed attributes
+	Schemes  []string            // URL schemes allowed in links and sources, relative URLs are always allowed
+	NoFollow bool                // add rel="nofollow" to links so search engines ignore them
+}
+
+// CommentSanitizeOptions
+// @Description: Get the options for user comments: text formatting, quotes, code, lists and links to
+// http, https and mailto URLs with rel="nofollow".
+// @return SanitizeOptions
+func CommentSanitizeOptions() SanitizeOptions {
+	return SanitizeOptions{
+		Tags: map[string][]string{
+			"a": {"href", "title", "rel"}, "abbr": {"title"}, "b": nil, "blockquote": {"cite"}, "br": nil,
+			"code": nil, "del": nil, "em": nil, "i": nil, "li": nil, "ol": {"start"}, "p": nil, "pre": nil,
+			"q": {"cite"}, "s": nil, "strong": nil, "sub": nil, "sup": nil, "u": nil, "ul": nil,
+		},
+		Schemes:  []string{"http", "https", "mailto"},
+		NoFollow: true,
+	}
+}
+
+// htmlUnsafeTags elements Sanitize always removes with their content, whatever the options allow.
+var htmlUnsafeTags = map[string]bool{
+	"applet": true, "base": true, "embed": true, "frame": true, "frameset": true, "iframe": true, "link": true,
+	"math": true, "meta": true, "noembed": true, "noframes": true, "noscript": true, "object": true,
+	"script": true, "style": true, "svg": true, "template": true, "xmp": true,
+}
+
+// htmlURLAttributes attributes holding a URL, their scheme is checked by Sanitize.
+var htmlURLAttributes = map[string]bool{
+	"action": true, "background": true, "cite": true, "formaction": true, "href": true, "longdesc": true,
+	"poster": true, "src": true, "srcset": true, "xlink:href": true,
+}
+
+// isAllowedURL
+// @Description: Determine if a URL is relative or uses an allowed scheme. White space and control
+// characters are ignored as browsers ignore them, so "java\tscript:" is still javascript.
+// @param value entities decoded
+// @param schemes lower case
+// @return bool
+func isAllowedURL(value string, schemes map[string]bool) bool {
+	cleaned := strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, value)
+	colon := strings.IndexByte(cleaned, ':')
+	if colon < 0 || strings.ContainsAny(cleaned[:colon], "/?#") {
+		return true
+	}
+	return schemes[strings.ToLower(cleaned[:colon])]
+}
+
+// Sanitize
+// @Description: Clean untrusted HTML, such as user comments, with an allow-list. Tags that are not
+// allowed are removed and their text kept, script, style, iframe and the like are removed with their
+// content, attributes that are not allowed, event handlers and URLs with other schemes are dropped, text is
+// escaped again and open tags are closed so the result can not break the page it is put in.
+// @param value
+// @param options see CommentSanitizeOptions
+// @return string
+func Sanitize(value string, options SanitizeOptions) string {
+	allowed := map[string]map[string]bool{}
+	for tag, attributes := range options.Tags {
+		tag = strings.ToLower(tag)
+		if htmlUnsafeTags[tag] {
+			continue
+		}
+		allowed[tag] = map[string]bool{}
+		for _, attribute := range attributes {
+			allowed[tag][strings.ToLower(attribute)] = true
+		}
+	}
+	schemes := map[string]bool{}
+	for _, scheme := range options.Schemes {
+		schemes[strings.ToLower(scheme)] = true
+	}
+	var builder strings.Builder
+	var open []string
+	skipName, skipDepth := "", 0
+	z := htmlTokenizer{input: value}
+	for token, ok := z.next(); ok; token, ok = z.next() {
+		if skipDepth > 0 {
+			switch {
+			case token.kind == htmlStartTag && token.name == skipName && !token.selfClosing:
+				skipDepth++
+			case token.kind == htmlEndTag && token.name == skipName:
+				skipDepth--
+			}
+			continue
+		}
+		switch token.kind {
+		case htmlText:
+			builder.WriteString(E(Unescape(token.raw)))
+		case htmlStartTag:
+			attributes, ok := allowed[token.name]
+			if !ok {
+				if htmlUnsafeTags[token.name] && !htmlVoidTags[token.name] && !token.selfClosing {
+					skipName, skipDepth = token.name, 1
+				}
+				continue
+			}
+			writeSanitizedTag(&builder, token, attributes, schemes, options.NoFollow)
+			switch {
+			case htmlVoidTags[token.name]:
+			case token.selfClosing:
+				builder.WriteString("</" + token.name + ">")
+			default:
+				open = append(open, token.name)
+			}
+		case htmlEndTag:
+			// an end tag closes the elements opened after its own, a stray end tag is dropped
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == token.name {
+					for len(open) > i {
+						builder.WriteString("</" + open[len(open)-1] + ">")
+						open = open[:len(open)-1]
+					}
+					break
+				}
+			}
+		}
+	}
+	for i := len(open) - 1; i >= 0; i-- {
+		builder.WriteString("</" + open[i] + ">")
+	}
+	return builder.String()
+}
+
+// writeSanitizedTag
+// @Description: Write a start tag with the attributes Sanitize keeps.
+// @param builder
+// @param token
+// @param attributes allowed attributes of the tag
+// @param schemes allowed URL schemes
+// @param noFollow
+func writeSanitizedTag(builder *strings.Builder, token htmlToken, attributes map[string]bool, schemes map[string]bool, noFollow bool) {
+	builder.WriteString("<" + token.name)
+	seen := map[string]bool{}
+	var rel []string
+	link := false
+	for _, attribute := range token.attributes {
+		name := attribute.name
+		if !attributes[name] || seen[name] || strings.HasPrefix(name, "on") {
+			continue
+		}
+		if htmlURLAttributes[name] && !isAllowedURL(attribute.value, schemes) {
+			continue
+		}
+		seen[name] = true
+		if name == "rel" {
+			rel = strings.Fields(attribute.value)
+			continue
+		}
+		link = link || name == "href"
+		builder.WriteString(" " + name + `="` + E(attribute.value) + `"`)
+	}
+	if noFollow && link && token.name == "a" {
+		nofollow := false
+		for _, value := range rel {
+			nofollow = nofollow || strings.EqualFold(value, "nofollow")
+		}
+		if !nofollow {
+			rel = append(rel, "nofollow")
+		}
+	}
+	if len(rel) > 0 {
+		builder.WriteString(` rel="` + E(strings.Join(rel, " ")) + `"`)
+	}
+	builder.WriteString(">")
+}
+
+// htmlBlockTags elements that start on a new line in plain text, the value is how many line breaks
+// surround them.
+var htmlBlockTags = map[string]int{
+	"address": 1, "article": 1, "aside": 1, "caption": 1, "dd": 1, "details": 1, "div": 1, "dl": 1, "dt": 1,
+	"fieldset": 1, "figcaption": 1, "figure": 1, "footer": 1, "form": 1, "header": 1, "main": 1, "nav": 1,
+	"section": 1, "summary": 1, "tr": 1,
+	"blockquote": 2, "h1": 2, "h2": 2, "h3": 2, "h4": 2, "h5": 2, "h6": 2, "hr": 2, "p": 2, "pre": 2, "table": 2,
+}
+
+// plainTextWriter
+// @Description: collects the plain text of an HTML document, collapsing white space as browsers do.
+type plainTextWriter struct {
+	out          []byte
+	pendingSpace bool
+}
+
+// write
+// @Description: Write text as it is.
+// @receiver w
+// @param value
+func (w *plainTextWriter) write(value string) {
+	if value == "" {
+		return
+	}
+	if w.pendingSpace && len(w.out) > 0 && w.out[len(w.out)-1] != '\n' && w.out[len(w.out)-1] != ' ' {
+		w.out = append(w.out, ' ')
+	}
+	w.pendingSpace = false
+	w.out = append(w.out, value...)
+}
+
+// text
+// @Description: Write text collapsing its white space into single spaces, none at the start of a line.
+// @receiver w
+// @param value
+func (w *plainTextWriter) text(value string) {
+	for i := 0; i < len(value); {
+		if isHTMLSpace(value[i]) {
+			w.pendingSpace = true
+			i++
+			continue
+		}
+		end := i
+		for end < len(value) && !isHTMLSpace(value[end]) {
+			end++
+		}
+		w.write(value[i:end])
+		i = end
+	}
+}
+
+// breakLines
+// @Description: End the current line and leave lines-1 blank lines, nothing is added at the start.
+// @receiver w
+// @param lines
+func (w *plainTextWriter) breakLines(lines int) {
+	w.pendingSpace = false
+	if len(w.out) == 0 {
+		return
+	}
+	trailing := 0
+	for i := len(w.out) - 1; i >= 0 && w.out[i] == '\n'; i-- {
+		trailing++
+	}
+	for ; trailing < lines; trailing++ {
+		w.out = append(w.out, '\n')
+	}
+}
+
+// ToPlainText
+// @Description: Convert HTML to readable text, for instance the text part of an email. Blocks and <br>
+// start new lines, paragraphs and headings are separated by a blank line, list items start with - or
+// their number, table cells are separated by tabs, links are followed by their URL in parentheses,
+// checkboxes become [x] or [ ] and images become their alt text. Scripts, styles and comments are
+// removed and entities decoded.
+// @param value
+// @return string
+func ToPlainText(value string) string {
+	w := &plainTextWriter{}
+	pre := 0
+	var lists []int // per open list, 0 for ul or the next number of an ol
+	marker := -1    // the end of the last list marker, the first block of an item stays on its line
+	type link struct {
+		href  string
+		start int
+	}
+	var links []link
+	cells := 0
+	skipName, skipDepth := "", 0
+	z := htmlTokenizer{input: value}
+	for token, ok := z.next(); ok; token, ok = z.next() {
+		if skipDepth > 0 {
+			switch {
+			case token.kind == htmlStartTag && token.name == skipName && !token.selfClosing:
+				skipDepth++
+			case token.kind == htmlEndTag && token.name == skipName:
+				skipDepth--
+			}
+			continue
+		}
+		switch token.kind {
+		case htmlText:
+			if pre > 0 {
+				w.write(Unescape(token.raw))
+			} else {
+				w.text(Unescape(token.raw))
+			}
+		case htmlStartTag:
+			switch name := token.name; {
+			case name == "script" || name == "style" || name == "title" || name == "template" || name == "head":
+				if !token.selfClosing {
+					skipName, skipDepth = name, 1
+				}
+			case name == "br":
+				w.pendingSpace = false
+				w.out = append(w.out, '\n')
+			case name == "hr":
+				w.breakLines(2)
+				w.write("---")
+				w.breakLines(2)
+			case name == "ul" || name == "ol":
+				number := 0
+				if name == "ol" {
+					number = 1
+					start, _ := token.attribute("start")
+					if start, e := strconv.Atoi(strings.TrimSpace(start)); e == nil {
+						number = start
+					}
+				}
+				if len(lists) == 0 {
+					w.breakLines(2)
+				} else {
+					w.breakLines(1)
+				}
+				lists = append(lists, number)
+			case name == "li":
+				w.breakLines(1)
+				bullet := "- "
+				if len(lists) > 0 {
+					w.write(strings.Repeat("  ", len(lists)-1))
+					if lists[len(lists)-1] > 0 {
+						bullet = strconv.Itoa(lists[len(lists)-1]) + ". "
+						lists[len(lists)-1]++
+					}
+				}
+				w.write(bullet)
+				marker = len(w.out)
+			case name == "td" || name == "th":
+				if cells > 0 {
+					w.pendingSpace = false
+					w.write("\t")
+				}
+				cells++
+			case name == "a":
+				href, _ := token.attribute("href")
+				links = append(links, link{href: strings.TrimSpace(href), start: len(w.out)})
+			case name == "input":
+				if kind, _ := token.attribute("type"); strings.EqualFold(kind, "checkbox") {
+					if _, checked := token.attribute("checked"); checked {
+						w.write("[x]")
+					} else {
+						w.write("[ ]")
+					}
+				}
+			case name == "img":
+				if alt, ok := token.attribute("alt"); ok {
+					w.text(alt)
+				}
+			case htmlBlockTags[name] > 0:
+				if len(w.out) == marker {
+					w.pendingSpace = false
+				} else {
+					w.breakLines(htmlBlockTags[name])
+				}
+				if name == "tr" {
+					cells = 0
+				}
+				if name == "pre" {
+					pre++
+					// a line break right after <pre> is not content
+					if strings.HasPrefix(z.input[z.pos:], "\n") {
+						z.pos++
+					}
+				}
+			}
+		case htmlEndTag:
+			switch name := token.name; {
+			case name == "ul" || name == "ol":
+				if len(lists) > 0 {
+					lists = lists[:len(lists)-1]
+				}
+				if len(lists) == 0 {
+					w.breakLines(2)
+				} else {
+					w.breakLines(1)
+				}
+			case name == "a":
+				if len(links) == 0 {
+					continue
+				}
+				current := links[len(links)-1]
+				links = links[:len(links)-1]
+				text := strings.TrimSpace(string(w.out[current.start:]))
+				if current.href != "" && !strings.HasPrefix(current.href, "#") &&
+					!strings.HasPrefix(strings.ToLower(current.href), "javascript:") &&
+					text != current.href && "mailto:"+text != current.href {
+					w.write(" (" + current.href + ")")
+				}
+			case htmlBlockTags[name] > 0:
+				if name == "pre" && pre > 0 {
+					pre--
+				}
+				w.breakLines(htmlBlockTags[name])
+			}
+		}
+	}
+	return strings.TrimRight(string(w.out), " \t\n")
+}
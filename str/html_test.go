@@ -0,0 +1,160 @@
+package str
+
+import (
+	"strings"
+	"testing"
+)
+
+func BenchmarkStripTags(t *testing.B) {
+	tests := []struct {
+		name    string
+		value   string
+		allowed []string
+		want    string
+	}{
+		{name: "tags", value: `<p>Hello <b class="x">world</b></p>`, want: "Hello world"},
+		{name: "allowed", value: `<p>Hello <b class="x">world</b></p>`, allowed: []string{"<b>"}, want: `Hello <b class="x">world</b>`},
+		{name: "allowed ignores case", value: `<B>bold</B>`, allowed: []string{"b"}, want: `<B>bold</B>`},
+		{name: "comments", value: "a<!-- <b>hidden</b> -->b<!DOCTYPE html>c", want: "abc"},
+		{name: "script content", value: `x<script>if (a < b) { alert("<p>") }</script>y<style>p{}</style>`, want: "xy"},
+		{name: "less than is text", value: "1 < 2 and 3 <= 4 <3", want: "1 < 2 and 3 <= 4 <3"},
+		{name: "quoted greater than", value: `<a title="a > b">link</a>`, want: "link"},
+		{name: "entities kept", value: "<i>&lt;tag&gt;</i>", want: "&lt;tag&gt;"},
+		{name: "unterminated", value: "text <b", want: "text "},
+		{name: "textarea content", value: "<textarea><script>alert(1)</script></textarea>", want: ""},
+		{name: "textarea text", value: "a<textarea>b <b>c</b></textarea>d", want: "ab cd"},
+		{name: "title content", value: "<title>A <i>title</i></title>", allowed: []string{"i"}, want: "A <i>title</i>"},
+		{name: "xmp content", value: "a<xmp><img src=x onerror=alert(1)></xmp>b", want: "ab"},
+		{name: "iframe content", value: "<iframe><b>x</b></iframe><noembed><b>y</b></noembed><noframes>z</noframes>", want: ""},
+		{name: "allowed textarea", value: "<textarea><b>x</b></textarea>", allowed: []string{"textarea"}, want: "<textarea><b>x</b></textarea>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := StripTags(tt.value, tt.allowed); got != tt.want {
+				t.Errorf("StripTags() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkUnescape(t *testing.B) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "named", value: "&lt;b&gt; &amp; &quot;x&quot; &copy; &nbsp;", want: "<b> & \"x\" ©  "},
+		{name: "numeric", value: "&#39;&#x27;&#8364;", want: "''€"},
+		{name: "unknown", value: "&nope; & done", want: "&nope; & done"},
+		{name: "round trip", value: E(`<a href="?a=1&b=2">'x'</a>`), want: `<a href="?a=1&b=2">'x'</a>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Unescape(tt.value); got != tt.want {
+				t.Errorf("Unescape() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSanitize(t *testing.B) {
+	options := CommentSanitizeOptions()
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "formatting", value: "<p>Hi <strong>there</strong></p>", want: "<p>Hi <strong>there</strong></p>"},
+		{name: "disallowed tag keeps text", value: `<div class="x">text</div>`, want: "text"},
+		{name: "script removed", value: `a<script>alert(1)</script>b<SCRIPT SRC=x.js></SCRIPT>c`, want: "abc"},
+		{name: "nested unsafe", value: "a<svg><svg></svg><a href=x>b</a></svg>c", want: "ac"},
+		{name: "event handler", value: `<b onclick="alert(1)">x</b>`, want: "<b>x</b>"},
+		{name: "attributes", value: `<a href="https://example.com" title='T "1"' class=x>l</a>`,
+			want: `<a href="https://example.com" title="T &#34;1&#34;" rel="nofollow">l</a>`},
+		{name: "javascript url", value: `<a href="javascript:alert(1)">x</a>`, want: "<a>x</a>"},
+		{name: "obfuscated url", value: `<a href="  JaVa&#x09;script&colon;alert(1)">x</a>`, want: "<a>x</a>"},
+		{name: "relative url", value: `<a href="/about?x=1:2">x</a>`, want: `<a href="/about?x=1:2" rel="nofollow">x</a>`},
+		{name: "existing rel", value: `<a href="mailto:a@b.c" rel="author">x</a>`, want: `<a href="mailto:a@b.c" rel="author nofollow">x</a>`},
+		{name: "text escaped", value: `1 < 2 & "q" &amp; <3`, want: "1 &lt; 2 &amp; &#34;q&#34; &amp; &lt;3"},
+		{name: "unclosed", value: "<ul><li><em>x</ul>y<b>z", want: "<ul><li><em>x</em></li></ul>y<b>z</b>"},
+		{name: "stray end tag", value: "a</b></p>b", want: "ab"},
+		{name: "void", value: "a<br/>b<br>c", want: "a<br>b<br>c"},
+		{name: "comment", value: "a<!-- <script>x</script> -->b", want: "ab"},
+		{name: "attribute breakout", value: `<b title="x"><img src=x onerror=alert(1)>">y</b>`, want: "<b>&#34;&gt;y</b>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Sanitize(tt.value, options); got != tt.want {
+				t.Errorf("Sanitize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	t.Run("unsafe tags can not be allowed", func(t *testing.B) {
+		got := Sanitize("<script>x</script><img src=data:x alt=a>", SanitizeOptions{Tags: map[string][]string{
+			"script": nil, "IMG": {"SRC", "alt"},
+		}})
+		if want := `<img alt="a">`; got != want {
+			t.Errorf("Sanitize() = %q, want %q", got, want)
+		}
+	})
+	t.Run("no nofollow", func(t *testing.B) {
+		got := Sanitize(`<a href="http://x">x</a>`, SanitizeOptions{Tags: map[string][]string{"a": {"href"}}, Schemes: []string{"http"}})
+		if want := `<a href="http://x">x</a>`; got != want {
+			t.Errorf("Sanitize() = %q, want %q", got, want)
+		}
+	})
+}
+
+func BenchmarkToPlainText(t *testing.B) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "paragraphs", value: "<h1>Title</h1><p>First\n   line</p><p>Second &amp; last</p>", want: "Title\n\nFirst line\n\nSecond & last"},
+		{name: "breaks", value: "a<br>b<br><br>c", want: "a\nb\n\nc"},
+		{name: "inline", value: "<p>Hello <b>big</b>  <i>world</i></p>", want: "Hello big world"},
+		{name: "lists", value: "<p>Items:</p><ul><li>one</li><li>two<ol start=3><li>x</li><li>y</li></ol></li></ul>after",
+			want: "Items:\n\n- one\n- two\n  3. x\n  4. y\n\nafter"},
+		{name: "links", value: `<a href="https://a.io">site</a> <a href="https://b.io">https://b.io</a> <a href="#top">top</a>`,
+			want: "site (https://a.io) https://b.io top"},
+		{name: "mailto", value: `<a href="mailto:a@b.c">a@b.c</a>`, want: "a@b.c"},
+		{name: "table", value: "<table><tr><th>Name</th><th>Age</th></tr><tr><td>Ann</td><td>3</td></tr></table>", want: "Name\tAge\nAnn\t3"},
+		{name: "pre", value: "<p>code:</p><pre>\n  if x {\n    y()\n  }</pre>done", want: "code:\n\n  if x {\n    y()\n  }\n\ndone"},
+		{name: "hidden", value: "<html><head><title>T</title><style>p{}</style></head><body>x<script>y</script></body></html>", want: "x"},
+		{name: "image", value: `see <img src="a.png" alt="a cat"> here`, want: "see a cat here"},
+		{name: "divs", value: "<div>a</div><div><div>b</div></div>", want: "a\nb"},
+		{name: "rule", value: "a<hr>b", want: "a\n\n---\n\nb"},
+		{name: "loose list", value: "<ul><li><p>a</p></li><li>\n<p>b</p><p>c</p></li></ul>", want: "- a\n\n- b\n\nc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := ToPlainText(tt.value); got != tt.want {
+				t.Errorf("ToPlainText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkHtmlTokenizer(t *testing.B) {
+	z := htmlTokenizer{input: `<A HREF='x' data-v=1 checked/>t</a ><textarea><b></textarea>`}
+	var got []string
+	for token, ok := z.next(); ok; token, ok = z.next() {
+		part := token.raw
+		if token.kind == htmlStartTag {
+			var attributes []string
+			for _, attribute := range token.attributes {
+				attributes = append(attributes, attribute.name+"="+attribute.value)
+			}
+			part = token.name + "[" + strings.Join(attributes, ",") + "]"
+			if token.selfClosing {
+				part += "/"
+			}
+		}
+		got = append(got, part)
+	}
+	want := []string{"a[href=x,data-v=1,checked=]/", "t", "</a >", "textarea[]", "<b>", "</textarea>"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("next() = %q, want %q", got, want)
+	}
+}
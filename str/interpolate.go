@@ -0,0 +1,249 @@
+package str
+
+import (
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MissingKeyPolicy what InterpolateWith does with a {name} placeholder that has no value.
+type MissingKeyPolicy int
+
+const (
+	MissingKeyLeave MissingKeyPolicy = iota // keep the placeholder as written
+	MissingKeyEmpty                         // replace the placeholder with an empty string
+	MissingKeyError                         // stop with an InvalidParamError
+)
+
+// InterpolationFilter changes a placeholder value, args are the comma separated values after the colon
+// in {name|filter:arg1,arg2}.
+type InterpolationFilter func(value string, args []string) (string, error)
+
+var (
+	interpolationMutex   sync.RWMutex
+	interpolationFilters = map[string]InterpolationFilter{}
+
+	// interpolationPlaceholder matches {name|filter:arg} in groups 1 and 2, or :name in group 3
+	interpolationPlaceholder = regexp.MustCompile(
+		`\{\s*([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z0-9_]+)*)\s*((?:\|[^{}|]+)*)\}|:([A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z0-9_]+)*)`,
+	)
+)
+
+func init() {
+	plain := map[string]func(string) string{
+		"upper": Upper, "lower": Lower, "ucfirst": UcFirst, "lcfirst": LcFirst, "title": Headline,
+		"snake": Snake, "kebab": Kebab, "camel": Camel, "studly": Studly, "squish": Squish,
+		"trim": strings.TrimSpace, "e": E, "reverse": Reverse, "singular": Singular,
+		"slug":   func(value string) string { return Slug(value, "-", "en", nil) },
+		"plural": func(value string) string { return Plural(value, 2) },
+	}
+	for name, function := range plain {
+		function := function
+		RegisterInterpolationFilter(name, func(value string, args []string) (string, error) {
+			return function(value), nil
+		})
+	}
+	// limit and words take a count and an optional end, {name|limit:10,…}
+	counted := map[string]func(string, int, string) string{"limit": Limit, "words": Words}
+	for name, function := range counted {
+		name, function := name, function
+		RegisterInterpolationFilter(name, func(value string, args []string) (string, error) {
+			if len(args) == 0 {
+				return "", exceptions.NewInvalidParamError(fmt.Sprintf("filter %s needs a count", name))
+			}
+			count, e := strconv.Atoi(strings.TrimSpace(args[0]))
+			if e != nil {
+				return "", exceptions.NewInvalidParamError(fmt.Sprintf("filter %s needs a count:%s", name, args[0]))
+			}
+			end := "..."
+			if len(args) > 1 {
+				end = args[1]
+			}
+			return function(value, count, end), nil
+		})
+	}
+	RegisterInterpolationFilter("default", func(value string, args []string) (string, error) {
+		if value == "" {
+			return strings.Join(args, ","), nil
+		}
+		return value, nil
+	})
+}
+
+// RegisterInterpolationFilter
+// @Description: Register a filter for {name|filter} placeholders, a filter of the same name is replaced.
+// @param name
+// @param filter
+func RegisterInterpolationFilter(name string, filter InterpolationFilter) {
+	interpolationMutex.Lock()
+	defer interpolationMutex.Unlock()
+	interpolationFilters[name] = filter
+}
+
+// lookupInterpolation
+// @Description: Find the value of a placeholder. A dotted name walks nested maps. A name with no exact key
+// falls back to its lower case key and copies the case of the name, :NAME gives the value in upper case
+// and :Name with its first letter in upper case.
+// @param values
+// @param name
+// @return string
+// @return bool
+func lookupInterpolation(values map[string]any, name string) (string, bool) {
+	find := func(name string) (any, bool) {
+		var current any = values
+		for _, part := range strings.Split(name, ".") {
+			nested, ok := current.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if current, ok = nested[part]; !ok {
+				return nil, false
+			}
+		}
+		return current, true
+	}
+	format := func(value any) string {
+		if value == nil {
+			return ""
+		}
+		return fmt.Sprint(value)
+	}
+	if value, ok := find(name); ok {
+		return format(value), true
+	}
+	lower := strings.ToLower(name)
+	value, ok := find(lower)
+	if !ok {
+		return "", false
+	}
+	switch name {
+	case strings.ToUpper(name):
+		return Upper(format(value)), true
+	case UcFirst(lower):
+		return UcFirst(format(value)), true
+	}
+	return format(value), true
+}
+
+// applyInterpolationFilters
+// @Description: Run the value through the filters of a placeholder, in order.
+// @param value
+// @param filters the filters as written, each starting with |
+// @return string
+// @return error an InvalidParamError for an unknown filter or bad arguments
+func applyInterpolationFilters(value string, filters string) (string, error) {
+	if filters == "" {
+		return value, nil
+	}
+	for _, filter := range strings.Split(filters[1:], "|") {
+		name, argument := filter, ""
+		if index := strings.Index(filter, ":"); index >= 0 {
+			name, argument = filter[:index], filter[index+1:]
+		}
+		name = strings.TrimSpace(name)
+		interpolationMutex.RLock()
+		function, ok := interpolationFilters[name]
+		interpolationMutex.RUnlock()
+		if !ok {
+			return "", exceptions.NewInvalidParamError(fmt.Sprintf("unknown interpolation filter:%s", name))
+		}
+		var args []string
+		if argument != "" {
+			args = strings.Split(argument, ",")
+		}
+		var e error
+		if value, e = function(value, args); e != nil {
+			return "", e
+		}
+	}
+	return value, nil
+}
+
+// interpolate
+// @Description: Replace the placeholders of a template.
+// @param template
+// @param values
+// @param missing
+// @param strict stop at a failing filter, otherwise leave its placeholder as written
+// @return string
+// @return error
+func interpolate(template string, values map[string]any, missing MissingKeyPolicy, strict bool) (string, error) {
+	var failure error
+	response := interpolationPlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		if failure != nil {
+			return placeholder
+		}
+		groups := interpolationPlaceholder.FindStringSubmatch(placeholder)
+		if groups[3] != "" {
+			if value, ok := lookupInterpolation(values, groups[3]); ok {
+				return value
+			}
+			return placeholder
+		}
+		value, ok := lookupInterpolation(values, groups[1])
+		if !ok {
+			switch missing {
+			case MissingKeyEmpty:
+				return ""
+			case MissingKeyError:
+				failure = exceptions.NewInvalidParamError(fmt.Sprintf("missing interpolation value:%s", groups[1]))
+			}
+			return placeholder
+		}
+		value, e := applyInterpolationFilters(value, groups[2])
+		if e != nil {
+			if strict {
+				failure = e
+			}
+			return placeholder
+		}
+		return value
+	})
+	if failure != nil {
+		return "", failure
+	}
+	return response, nil
+}
+
+// InterpolateWith
+// @Description: Replace the :name and {name} placeholders of a template with values. Placeholders copy
+// the case of their name as in Laravel translations (:NAME, :Name), {name|upper|limit:10} runs the value
+// through filters, and {user.name} reads nested maps. A :name without a value is always left alone
+// because colons are common in text, missing {name} values follow the policy.
+// @param template
+// @param values
+// @param missing
+// @return string
+// @return error an InvalidParamError for an unknown filter, bad filter arguments or, with
+// MissingKeyError, a missing value
+func InterpolateWith(template string, values map[string]any, missing MissingKeyPolicy) (string, error) {
+	return interpolate(template, values, missing, true)
+}
+
+// Interpolate
+// @Description: Replace the :name and {name} placeholders of a template with values, see InterpolateWith.
+// Placeholders without a value or with a failing filter are left as written.
+// @param template
+// @param values
+// @return string
+func Interpolate(template string, values map[string]any) string {
+	response, _ := interpolate(template, values, MissingKeyLeave, false)
+	return response
+}
+
+// InterpolationFilters
+// @Description: Get the names of the registered filters, sorted.
+// @return response
+func InterpolationFilters() (response []string) {
+	interpolationMutex.RLock()
+	defer interpolationMutex.RUnlock()
+	for name := range interpolationFilters {
+		response = append(response, name)
+	}
+	sort.Strings(response)
+	return response
+}
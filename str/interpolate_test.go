@@ -0,0 +1,86 @@
+package str
+
+import (
+	"errors"
+	"github.com/melodywen/supports/exceptions"
+	"strings"
+	"testing"
+)
+
+func BenchmarkInterpolate(t *testing.B) {
+	values := map[string]any{
+		"name":  "taylor",
+		"count": 3,
+		"names": "ignored",
+		"user":  map[string]any{"city": "北京"},
+		"empty": nil,
+		"title": "the quick brown fox jumps",
+	}
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{name: "colon", template: "Hello :name, you have :count items", want: "Hello taylor, you have 3 items"},
+		{name: "longest name", template: ":names :name", want: "ignored taylor"},
+		{name: "upper", template: "Hello :NAME", want: "Hello TAYLOR"},
+		{name: "ucfirst", template: "Hello :Name", want: "Hello Taylor"},
+		{name: "brace", template: "Hello {name}!", want: "Hello taylor!"},
+		{name: "brace spaces", template: "Hello { name }", want: "Hello taylor"},
+		{name: "filters", template: "{name|upper|limit:3}", want: "TAY..."},
+		{name: "filter arguments", template: "{title|words:2,…}", want: "the quick…"},
+		{name: "default", template: "{empty|default:n/a}", want: "n/a"},
+		{name: "nested", template: "From :user.city", want: "From 北京"},
+		{name: "time is text", template: "at 10:30 and :missing", want: "at 10:30 and :missing"},
+		{name: "missing brace", template: "Hi {missing}", want: "Hi {missing}"},
+		{name: "unknown filter", template: "{name|nope} :name", want: "{name|nope} taylor"},
+		{name: "values are not placeholders", template: "{a} {b}", want: ":b {b}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			v := values
+			if tt.name == "values are not placeholders" {
+				v = map[string]any{"a": ":b", "b": "{b}"}
+			}
+			if got := Interpolate(tt.template, v); got != tt.want {
+				t.Errorf("Interpolate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkInterpolateWith(t *testing.B) {
+	values := map[string]any{"name": "taylor"}
+	t.Run("empty", func(t *testing.B) {
+		got, e := InterpolateWith("Hi {missing}:name", values, MissingKeyEmpty)
+		if e != nil || got != "Hi taylor" {
+			t.Errorf("InterpolateWith() = %q, %v, want %q", got, e, "Hi taylor")
+		}
+	})
+	t.Run("error", func(t *testing.B) {
+		_, e := InterpolateWith("Hi {missing}", values, MissingKeyError)
+		var invalid *exceptions.InvalidParamError
+		if !errors.As(e, &invalid) {
+			t.Errorf("InterpolateWith() error = %v, want InvalidParamError", e)
+		}
+	})
+	t.Run("unknown filter", func(t *testing.B) {
+		if _, e := InterpolateWith("{name|nope}", values, MissingKeyLeave); e == nil {
+			t.Errorf("InterpolateWith() error = nil, want an error")
+		}
+	})
+	t.Run("bad argument", func(t *testing.B) {
+		if _, e := InterpolateWith("{name|limit:x}", values, MissingKeyLeave); e == nil {
+			t.Errorf("InterpolateWith() error = nil, want an error")
+		}
+	})
+	t.Run("custom filter", func(t *testing.B) {
+		RegisterInterpolationFilter("shout", func(value string, args []string) (string, error) {
+			return strings.ToUpper(value) + strings.Repeat("!", len(args)+1), nil
+		})
+		got, e := InterpolateWith("{name|shout:a,b}", values, MissingKeyError)
+		if e != nil || got != "TAYLOR!!!" {
+			t.Errorf("InterpolateWith() = %q, %v, want %q", got, e, "TAYLOR!!!")
+		}
+	})
+}
@@ -0,0 +1,1401 @@
+package str
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MarkdownOptions
+// @Description: how Markdown renders HTML.
+type MarkdownOptions struct {
+	SafeMode   bool // escape raw HTML with E and drop links and images to schemes other than http, https, mailto and tel
+	HardBreaks bool // render every line break inside a paragraph as <br />
+}
+
+// markdownBlockKind the kinds of block a Markdown document is made of.
+type markdownBlockKind int
+
+const (
+	markdownParagraph markdownBlockKind = iota
+	markdownHeading
+	markdownThematicBreak
+	markdownCode
+	markdownHTML
+	markdownQuote
+	markdownList
+	markdownListItem
+	markdownTable
+)
+
+// markdownBlock
+// @Description: a block of a Markdown document.
+type markdownBlock struct {
+	kind     markdownBlockKind
+	level    int              // heading level
+	text     string           // inline source of a paragraph or heading, content of a code or html block
+	info     string           // language of a fenced code block
+	children []*markdownBlock // content of a quote, list or list item
+	ordered  bool
+	start    int
+	tight    bool     // list items are rendered without <p>
+	loose    bool     // a blank line separates the block from the one before it
+	task     int      // list item: 0 not a task, 1 open, 2 done
+	align    []string // table column alignment: "", left, right or center
+	rows     [][]string
+}
+
+// markdownReference the destination of a [label]: /url "title" definition.
+type markdownReference struct {
+	url   string
+	title string
+}
+
+// markdownParser
+// @Description: parses Markdown into blocks, collecting link reference definitions on the way.
+type markdownParser struct {
+	references map[string]markdownReference
+}
+
+var (
+	markdownATXHeading     = regexp.MustCompile(`^(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	markdownOrderedItem    = regexp.MustCompile(`^([0-9]{1,9})([.)])`)
+	markdownReferenceLine  = regexp.MustCompile(`^\[((?:[^\[\]\\]|\\.)+)\]:[ \t]*(<[^<>\n]*>|\S+)(?:[ \t]+("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'|\((?:[^()\\]|\\.)*\)))?[ \t]*$`)
+	markdownTableDelimiter = regexp.MustCompile(`^\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?$`)
+	markdownEntity         = regexp.MustCompile(`^&(?:#[xX][0-9a-fA-F]{1,6}|#[0-9]{1,7}|[A-Za-z][A-Za-z0-9]{1,31});`)
+	markdownAutolink       = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.-]{1,31}:[^\s<>]*)>`)
+	markdownEmailAutolink  = regexp.MustCompile(`^<([A-Za-z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?(?:\.[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*)>`)
+	markdownInlineHTML     = regexp.MustCompile(`^(?:<[A-Za-z][A-Za-z0-9-]*(?:\s+[A-Za-z_:][A-Za-z0-9_.:-]*(?:\s*=\s*(?:[^\s"'=<>` + "`" + `]+|'[^']*'|"[^"]*"))?)*\s*/?>|</[A-Za-z][A-Za-z0-9-]*\s*>|<!--(?s:.*?)-->)`)
+	markdownWebLink        = regexp.MustCompile(`^(?:https?://|www\.)[A-Za-z0-9_-]+(?:\.[A-Za-z0-9_-]+)*[^\s<]*`)
+	markdownEmailLink      = regexp.MustCompile(`^[A-Za-z0-9._+-]+@[A-Za-z0-9_-]+(?:\.[A-Za-z0-9_-]+)+`)
+)
+
+// markdownHTMLBlockTags tags that start an HTML block when a line opens with them.
+var markdownHTMLBlockTags = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "body": true, "caption": true,
+	"center": true, "col": true, "colgroup": true, "dd": true, "details": true, "dialog": true, "div": true,
+	"dl": true, "dt": true, "fieldset": true, "figcaption": true, "figure": true, "footer": true, "form": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "head": true, "header": true,
+	"hr": true, "html": true, "iframe": true, "li": true, "main": true, "nav": true, "ol": true, "p": true,
+	"pre": true, "script": true, "section": true, "style": true, "summary": true, "table": true, "tbody": true,
+	"td": true, "textarea": true, "tfoot": true, "th": true, "thead": true, "tr": true, "ul": true,
+}
+
+// markdownIndent
+// @Description: Get the columns of leading white space of a line, tabs stop every 4 columns.
+// @param line
+// @return int
+func markdownIndent(line string) int {
+	column := 0
+	for _, c := range []byte(line) {
+		switch c {
+		case ' ':
+			column++
+		case '\t':
+			column += 4 - column%4
+		default:
+			return column
+		}
+	}
+	return column
+}
+
+// cutMarkdownIndent
+// @Description: Remove up to n columns of leading white space, a tab that is only partly removed leaves
+// its remaining columns as spaces.
+// @param line
+// @param n
+// @return string
+func cutMarkdownIndent(line string, n int) string {
+	column := 0
+	for i := 0; i < len(line) && column < n; i++ {
+		switch line[i] {
+		case ' ':
+			column++
+		case '\t':
+			width := 4 - column%4
+			if column+width > n {
+				return strings.Repeat(" ", column+width-n) + line[i+1:]
+			}
+			column += width
+		default:
+			return line[i:]
+		}
+		if column == n {
+			return line[i+1:]
+		}
+	}
+	if column < n {
+		return strings.TrimLeft(line, " \t")
+	}
+	return line
+}
+
+// isMarkdownBlank
+// @Description: Determine if a line holds only white space.
+// @param line
+// @return bool
+func isMarkdownBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// isMarkdownThematicBreak
+// @Description: Determine if a line is ***, --- or ___, three or more of the same character with optional spaces.
+// @param trimmed the line without its indentation
+// @return bool
+func isMarkdownThematicBreak(trimmed string) bool {
+	if trimmed == "" || !strings.ContainsRune("*-_", rune(trimmed[0])) {
+		return false
+	}
+	count := 0
+	for _, c := range []byte(trimmed) {
+		switch c {
+		case trimmed[0]:
+			count++
+		case ' ', '\t':
+		default:
+			return false
+		}
+	}
+	return count >= 3
+}
+
+// markdownFence
+// @Description: Get the opening fence of a fenced code block, ``` or ~~~ or longer.
+// @param trimmed the line without its indentation
+// @return fence
+// @return info
+// @return ok
+func markdownFence(trimmed string) (fence string, info string, ok bool) {
+	if len(trimmed) < 3 || (trimmed[0] != '`' && trimmed[0] != '~') {
+		return "", "", false
+	}
+	n := 0
+	for n < len(trimmed) && trimmed[n] == trimmed[0] {
+		n++
+	}
+	info = strings.TrimSpace(trimmed[n:])
+	if n < 3 || (trimmed[0] == '`' && strings.Contains(info, "`")) {
+		return "", "", false
+	}
+	return trimmed[:n], info, true
+}
+
+// markdownItem
+// @Description: the marker of a list item.
+type markdownItem struct {
+	ordered   bool
+	delimiter byte // - * + for bullets, . or ) after a number
+	start     int
+	indent    int    // the column the content of the item starts at
+	content   string // the content on the marker's line
+}
+
+// parseMarkdownItem
+// @Description: Get the list item marker a line starts with.
+// @param line
+// @return item
+// @return ok
+func parseMarkdownItem(line string) (item markdownItem, ok bool) {
+	indent := markdownIndent(line)
+	if indent >= 4 {
+		return item, false
+	}
+	trimmed := strings.TrimLeft(line, " \t")
+	width := 0
+	switch {
+	case trimmed != "" && strings.ContainsRune("-*+", rune(trimmed[0])):
+		item.delimiter, width = trimmed[0], 1
+	default:
+		groups := markdownOrderedItem.FindStringSubmatch(trimmed)
+		if groups == nil {
+			return item, false
+		}
+		item.ordered, item.delimiter, width = true, groups[2][0], len(groups[0])
+		item.start, _ = strconv.Atoi(groups[1])
+	}
+	rest := trimmed[width:]
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+		return item, false
+	}
+	spaces := markdownIndent(rest)
+	switch {
+	case isMarkdownBlank(rest):
+		item.indent = indent + width + 1
+	case spaces > 4:
+		// the content is indented code, only one space belongs to the marker
+		item.indent = indent + width + 1
+		item.content = cutMarkdownIndent(rest, 1)
+	default:
+		item.indent = indent + width + spaces
+		item.content = strings.TrimLeft(rest, " \t")
+	}
+	return item, true
+}
+
+// markdownHTMLBlockEnd
+// @Description: Get how an HTML block that a line starts ends: at a closing tag or comment end on a
+// line, or at a blank line.
+// @param trimmed the line without its indentation
+// @return end the text that ends the block, empty for a blank line
+// @return ok false when the line starts no HTML block
+func markdownHTMLBlockEnd(trimmed string) (end string, ok bool) {
+	switch {
+	case strings.HasPrefix(trimmed, "<!--"):
+		return "-->", true
+	case strings.HasPrefix(trimmed, "<?"):
+		return "?>", true
+	case strings.HasPrefix(trimmed, "<![CDATA["):
+		return "]]>", true
+	case strings.HasPrefix(trimmed, "<!") && len(trimmed) > 2 && isASCIILetter(trimmed[2]):
+		return ">", true
+	case !strings.HasPrefix(trimmed, "<"):
+		return "", false
+	}
+	name := strings.TrimPrefix(trimmed[1:], "/")
+	n := 0
+	for n < len(name) && (isASCIILetter(name[n]) || name[n] >= '0' && name[n] <= '9') {
+		n++
+	}
+	tag := strings.ToLower(name[:n])
+	if !markdownHTMLBlockTags[tag] {
+		return "", false
+	}
+	if rest := name[n:]; rest != "" && rest[0] != ' ' && rest[0] != '\t' && rest[0] != '>' && !strings.HasPrefix(rest, "/>") {
+		return "", false
+	}
+	switch tag {
+	case "script", "pre", "style", "textarea":
+		if !strings.HasPrefix(trimmed, "</") {
+			return "</" + tag + ">", true
+		}
+	}
+	return "", true
+}
+
+// startsMarkdownBlock
+// @Description: Determine if a line starts a block that interrupts a paragraph.
+// @param line
+// @return bool
+func startsMarkdownBlock(line string) bool {
+	if markdownIndent(line) >= 4 {
+		return false
+	}
+	trimmed := strings.TrimLeft(line, " \t")
+	if _, _, ok := markdownFence(trimmed); ok {
+		return true
+	}
+	if _, ok := markdownHTMLBlockEnd(trimmed); ok {
+		return true
+	}
+	if item, ok := parseMarkdownItem(line); ok && item.content != "" && (!item.ordered || item.start == 1) {
+		return true
+	}
+	return markdownATXHeading.MatchString(trimmed) || isMarkdownThematicBreak(trimmed) || strings.HasPrefix(trimmed, ">")
+}
+
+// splitMarkdownRow
+// @Description: Split a table row into its cells at the pipes that are not escaped.
+// @param line
+// @return response
+func splitMarkdownRow(line string) (response []string) {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+	start := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '|':
+			response = append(response, line[start:i])
+			start = i + 1
+		}
+	}
+	response = append(response, line[start:])
+	for i, cell := range response {
+		response[i] = strings.ReplaceAll(strings.TrimSpace(cell), `\|`, "|")
+	}
+	return response
+}
+
+// normalizeMarkdownLabel
+// @Description: Normalize a link label, labels match ignoring case and runs of white space.
+// @param label
+// @return string
+func normalizeMarkdownLabel(label string) string {
+	return strings.ToLower(strings.Join(strings.Fields(label), " "))
+}
+
+// parseBlocks
+// @Description: Parse lines into blocks.
+// @receiver p
+// @param lines
+// @return blocks
+func (p *markdownParser) parseBlocks(lines []string) (blocks []*markdownBlock) {
+	blank := false
+	add := func(block *markdownBlock) {
+		block.loose, blank = blank, false
+		blocks = append(blocks, block)
+	}
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if isMarkdownBlank(line) {
+			blank = len(blocks) > 0
+			i++
+			continue
+		}
+		indent := markdownIndent(line)
+		trimmed := strings.TrimLeft(line, " \t")
+		if indent >= 4 {
+			var code []string
+			for ; i < len(lines) && (markdownIndent(lines[i]) >= 4 || isMarkdownBlank(lines[i])); i++ {
+				code = append(code, cutMarkdownIndent(lines[i], 4))
+			}
+			for len(code) > 0 && isMarkdownBlank(code[len(code)-1]) {
+				code = code[:len(code)-1]
+			}
+			add(&markdownBlock{kind: markdownCode, text: strings.Join(code, "\n") + "\n"})
+			continue
+		}
+		if fence, info, ok := markdownFence(trimmed); ok {
+			var code []string
+			for i++; i < len(lines); i++ {
+				closing := strings.TrimSpace(lines[i])
+				if markdownIndent(lines[i]) < 4 && strings.HasPrefix(closing, fence) && strings.Trim(closing, fence[:1]) == "" {
+					i++
+					break
+				}
+				code = append(code, cutMarkdownIndent(lines[i], indent))
+			}
+			text := strings.Join(code, "\n")
+			if len(code) > 0 {
+				text += "\n"
+			}
+			if index := strings.IndexAny(info, " \t"); index >= 0 {
+				info = info[:index]
+			}
+			add(&markdownBlock{kind: markdownCode, text: text, info: unescapeMarkdown(info)})
+			continue
+		}
+		if groups := markdownATXHeading.FindStringSubmatch(trimmed); groups != nil {
+			add(&markdownBlock{kind: markdownHeading, level: len(groups[1]), text: groups[2]})
+			i++
+			continue
+		}
+		if isMarkdownThematicBreak(trimmed) {
+			add(&markdownBlock{kind: markdownThematicBreak})
+			i++
+			continue
+		}
+		if strings.HasPrefix(trimmed, ">") {
+			var quoted []string
+			for ; i < len(lines); i++ {
+				current := strings.TrimLeft(lines[i], " \t")
+				switch {
+				case markdownIndent(lines[i]) < 4 && strings.HasPrefix(current, ">"):
+					current = current[1:]
+					if strings.HasPrefix(current, " ") || strings.HasPrefix(current, "\t") {
+						current = cutMarkdownIndent(current, 1)
+					}
+					quoted = append(quoted, current)
+					continue
+				case !isMarkdownBlank(lines[i]) && len(quoted) > 0 && !isMarkdownBlank(quoted[len(quoted)-1]) &&
+					!startsMarkdownBlock(lines[i]):
+					// a lazy continuation of the quoted paragraph
+					quoted = append(quoted, current)
+					continue
+				}
+				break
+			}
+			add(&markdownBlock{kind: markdownQuote, children: p.parseBlocks(quoted)})
+			continue
+		}
+		if _, ok := parseMarkdownItem(line); ok {
+			var list *markdownBlock
+			list, i = p.parseList(lines, i)
+			add(list)
+			continue
+		}
+		if end, ok := markdownHTMLBlockEnd(trimmed); ok {
+			var raw []string
+			for ; i < len(lines); i++ {
+				if end == "" && isMarkdownBlank(lines[i]) {
+					break
+				}
+				raw = append(raw, lines[i])
+				if end != "" && strings.Contains(lines[i], end) {
+					i++
+					break
+				}
+			}
+			add(&markdownBlock{kind: markdownHTML, text: strings.Join(raw, "\n") + "\n"})
+			continue
+		}
+		if i+1 < len(lines) && strings.Contains(line, "|") && markdownTableDelimiter.MatchString(strings.TrimSpace(lines[i+1])) {
+			header, delimiter := splitMarkdownRow(line), splitMarkdownRow(lines[i+1])
+			if len(header) == len(delimiter) {
+				table := &markdownBlock{kind: markdownTable, rows: [][]string{header}}
+				for _, cell := range delimiter {
+					switch {
+					case strings.HasPrefix(cell, ":") && strings.HasSuffix(cell, ":"):
+						table.align = append(table.align, "center")
+					case strings.HasPrefix(cell, ":"):
+						table.align = append(table.align, "left")
+					case strings.HasSuffix(cell, ":"):
+						table.align = append(table.align, "right")
+					default:
+						table.align = append(table.align, "")
+					}
+				}
+				for i += 2; i < len(lines) && !isMarkdownBlank(lines[i]) && !startsMarkdownBlock(lines[i]); i++ {
+					row := splitMarkdownRow(lines[i])
+					for len(row) < len(header) {
+						row = append(row, "")
+					}
+					table.rows = append(table.rows, row[:len(header)])
+				}
+				add(table)
+				continue
+			}
+		}
+		var paragraph []string
+		var heading *markdownBlock
+		for ; i < len(lines) && !isMarkdownBlank(lines[i]); i++ {
+			if len(paragraph) > 0 {
+				underline := strings.TrimSpace(lines[i])
+				if markdownIndent(lines[i]) < 4 && (strings.Trim(underline, "=") == "" || strings.Trim(underline, "-") == "") {
+					heading = &markdownBlock{kind: markdownHeading, level: 1}
+					if underline[0] == '-' {
+						heading.level = 2
+					}
+					i++
+					break
+				}
+				if startsMarkdownBlock(lines[i]) {
+					break
+				}
+			}
+			paragraph = append(paragraph, strings.TrimLeft(lines[i], " \t"))
+		}
+		paragraph = p.definitions(paragraph)
+		if len(paragraph) == 0 {
+			continue
+		}
+		text := strings.TrimRight(strings.Join(paragraph, "\n"), " \t")
+		if heading != nil {
+			heading.text = text
+			add(heading)
+			continue
+		}
+		add(&markdownBlock{kind: markdownParagraph, text: text})
+	}
+	return blocks
+}
+
+// definitions
+// @Description: Collect the link reference definitions a paragraph starts with, the first definition
+// of a label wins.
+// @receiver p
+// @param paragraph
+// @return []string the rest of the paragraph
+func (p *markdownParser) definitions(paragraph []string) []string {
+	for len(paragraph) > 0 {
+		groups := markdownReferenceLine.FindStringSubmatch(paragraph[0])
+		if groups == nil {
+			break
+		}
+		label := normalizeMarkdownLabel(groups[1])
+		if _, ok := p.references[label]; !ok && label != "" {
+			url := strings.TrimSuffix(strings.TrimPrefix(groups[2], "<"), ">")
+			title := groups[3]
+			if title != "" {
+				title = title[1 : len(title)-1]
+			}
+			p.references[label] = markdownReference{url: unescapeMarkdown(url), title: unescapeMarkdown(title)}
+		}
+		paragraph = paragraph[1:]
+	}
+	return paragraph
+}
+
+// parseList
+// @Description: Parse the list starting at lines[i], items continue while they have the same kind of marker.
+// @receiver p
+// @param lines
+// @param i
+// @return list
+// @return next index of the first line after the list
+func (p *markdownParser) parseList(lines []string, i int) (list *markdownBlock, next int) {
+	first, _ := parseMarkdownItem(lines[i])
+	list = &markdownBlock{kind: markdownList, ordered: first.ordered, start: first.start, tight: true}
+	blanks := 0 // blank lines after the last item, they belong to what follows the list
+	for i < len(lines) {
+		item, ok := parseMarkdownItem(lines[i])
+		if !ok || item.ordered != first.ordered || item.delimiter != first.delimiter ||
+			isMarkdownThematicBreak(strings.TrimLeft(lines[i], " \t")) {
+			break
+		}
+		content := []string{item.content}
+		for i++; i < len(lines); i++ {
+			switch {
+			case isMarkdownBlank(lines[i]):
+				content = append(content, "")
+				continue
+			case markdownIndent(lines[i]) >= item.indent:
+				content = append(content, cutMarkdownIndent(lines[i], item.indent))
+				continue
+			case !isMarkdownBlank(content[len(content)-1]) && !startsMarkdownBlock(lines[i]) && !isMarkdownItem(lines[i]):
+				// a lazy continuation of the item's paragraph
+				content = append(content, strings.TrimLeft(lines[i], " \t"))
+				continue
+			}
+			break
+		}
+		end := len(content)
+		for end > 0 && isMarkdownBlank(content[end-1]) {
+			end--
+		}
+		trailingBlank := end < len(content)
+		// the marker's own line is never a trailing blank line, even for an empty item
+		blanks = len(content) - end
+		if end == 0 {
+			blanks--
+		}
+		content = content[:end]
+		child := &markdownBlock{kind: markdownListItem}
+		if len(content) > 0 {
+			switch {
+			case strings.HasPrefix(content[0], "[ ] "):
+				child.task, content[0] = 1, content[0][4:]
+			case strings.HasPrefix(content[0], "[x] "), strings.HasPrefix(content[0], "[X] "):
+				child.task, content[0] = 2, content[0][4:]
+			}
+		}
+		child.children = p.parseBlocks(content)
+		for _, block := range child.children {
+			// blocks of an item separated by a blank line make the list loose
+			if block.loose {
+				list.tight = false
+			}
+		}
+		list.children = append(list.children, child)
+		if trailingBlank && i < len(lines) {
+			if next, ok := parseMarkdownItem(lines[i]); ok && next.ordered == first.ordered && next.delimiter == first.delimiter {
+				list.tight = false
+			}
+		}
+	}
+	return list, i - blanks
+}
+
+// isMarkdownItem
+// @Description: Determine if a line starts a list item.
+// @param line
+// @return bool
+func isMarkdownItem(line string) bool {
+	_, ok := parseMarkdownItem(line)
+	return ok
+}
+
+// unescapeMarkdown
+// @Description: Remove the backslashes escaping punctuation and decode entities, as in link destinations.
+// @param value
+// @return string
+func unescapeMarkdown(value string) string {
+	if !strings.ContainsAny(value, `\&`) {
+		return value
+	}
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) && isASCIIPunct(value[i+1]) {
+			i++
+		}
+		builder.WriteByte(value[i])
+	}
+	return html.UnescapeString(builder.String())
+}
+
+// isASCIIPunct
+// @Description: Determine if a byte is ASCII punctuation, which a backslash escapes in Markdown.
+// @param c
+// @return bool
+func isASCIIPunct(c byte) bool {
+	return c >= '!' && c <= '/' || c >= ':' && c <= '@' || c >= '[' && c <= '`' || c >= '{' && c <= '~'
+}
+
+// markdownInlineKind the kinds of inline content.
+type markdownInlineKind int
+
+const (
+	markdownText markdownInlineKind = iota
+	markdownCodeSpan
+	markdownRawHTML
+	markdownLink
+	markdownImage
+	markdownSoftBreak
+	markdownHardBreak
+	markdownEmphasis
+	markdownStrong
+	markdownStrike
+	markdownDelimiter // a run of * _ or ~ not yet matched
+)
+
+// markdownInline
+// @Description: inline content of a paragraph, heading or table cell.
+type markdownInline struct {
+	kind     markdownInlineKind
+	text     string
+	url      string
+	title    string
+	children []*markdownInline
+	// a delimiter run
+	char     byte
+	count    int
+	original int
+	canOpen  bool
+	canClose bool
+}
+
+// markdownInlineParser
+// @Description: scans inline Markdown into a list of inlines.
+type markdownInlineParser struct {
+	parser     *markdownParser
+	hardBreaks bool
+	text       string
+	pos        int
+	nodes      []*markdownInline
+	buffer     []byte // text not yet added to nodes
+}
+
+// flush
+// @Description: Add the buffered text as a text inline.
+// @receiver ip
+func (ip *markdownInlineParser) flush() {
+	if len(ip.buffer) > 0 {
+		ip.nodes = append(ip.nodes, &markdownInline{kind: markdownText, text: string(ip.buffer)})
+		ip.buffer = ip.buffer[:0]
+	}
+}
+
+// add
+// @Description: Add an inline after the buffered text.
+// @receiver ip
+// @param node
+func (ip *markdownInlineParser) add(node *markdownInline) {
+	ip.flush()
+	ip.nodes = append(ip.nodes, node)
+}
+
+// isMarkdownPunct
+// @Description: Determine if a rune is punctuation for the emphasis rules.
+// @param r
+// @return bool
+func isMarkdownPunct(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// parseInlines
+// @Description: Parse inline Markdown: code spans, emphasis, strikethrough, links, images, autolinks,
+// raw HTML, entities, escapes and line breaks.
+// @receiver p
+// @param text
+// @param hardBreaks
+// @return []*markdownInline
+func (p *markdownParser) parseInlines(text string, hardBreaks bool) []*markdownInline {
+	ip := &markdownInlineParser{parser: p, hardBreaks: hardBreaks, text: text}
+	for ip.pos < len(text) {
+		c := text[ip.pos]
+		switch {
+		case c == '\\' && ip.pos+1 < len(text) && text[ip.pos+1] == '\n':
+			ip.add(&markdownInline{kind: markdownHardBreak})
+			ip.pos += 2
+			ip.skipLeadingSpace()
+		case c == '\\' && ip.pos+1 < len(text) && isASCIIPunct(text[ip.pos+1]):
+			ip.buffer = append(ip.buffer, text[ip.pos+1])
+			ip.pos += 2
+		case c == '`':
+			ip.codeSpan()
+		case c == '*' || c == '_' || c == '~':
+			ip.delimiter()
+		case c == '!' && ip.pos+1 < len(text) && text[ip.pos+1] == '[' && ip.link(true):
+		case c == '[' && ip.link(false):
+		case c == '<' && ip.angle():
+		case c == '&':
+			if entity := markdownEntity.FindString(text[ip.pos:]); entity != "" {
+				ip.buffer = append(ip.buffer, html.UnescapeString(entity)...)
+				ip.pos += len(entity)
+			} else {
+				ip.buffer = append(ip.buffer, c)
+				ip.pos++
+			}
+		case c == '\n':
+			trimmed := strings.TrimRight(string(ip.buffer), " ")
+			hard := ip.hardBreaks || len(ip.buffer)-len(trimmed) >= 2
+			ip.buffer = append(ip.buffer[:0], trimmed...)
+			if hard {
+				ip.add(&markdownInline{kind: markdownHardBreak})
+			} else {
+				ip.add(&markdownInline{kind: markdownSoftBreak})
+			}
+			ip.pos++
+			ip.skipLeadingSpace()
+		case ip.extendedAutolink():
+		default:
+			ip.buffer = append(ip.buffer, c)
+			ip.pos++
+		}
+	}
+	ip.flush()
+	return processMarkdownEmphasis(ip.nodes)
+}
+
+// skipLeadingSpace
+// @Description: Skip the spaces a line starts with.
+// @receiver ip
+func (ip *markdownInlineParser) skipLeadingSpace() {
+	for ip.pos < len(ip.text) && (ip.text[ip.pos] == ' ' || ip.text[ip.pos] == '\t') {
+		ip.pos++
+	}
+}
+
+// backtickRun
+// @Description: Get the length of the run of backticks at a position.
+// @receiver ip
+// @param pos
+// @return int
+func (ip *markdownInlineParser) backtickRun(pos int) int {
+	n := 0
+	for pos+n < len(ip.text) && ip.text[pos+n] == '`' {
+		n++
+	}
+	return n
+}
+
+// codeSpanEnd
+// @Description: Find the end of the code span opened by a run of backticks.
+// @receiver ip
+// @param pos
+// @param n
+// @return int the index after the closing run, -1 when the run is not closed
+func (ip *markdownInlineParser) codeSpanEnd(pos int, n int) int {
+	for i := pos + n; i < len(ip.text); {
+		if ip.text[i] != '`' {
+			i++
+			continue
+		}
+		run := ip.backtickRun(i)
+		if run == n {
+			return i + run
+		}
+		i += run
+	}
+	return -1
+}
+
+// codeSpan
+// @Description: Parse a code span, an unclosed run of backticks is text.
+// @receiver ip
+func (ip *markdownInlineParser) codeSpan() {
+	n := ip.backtickRun(ip.pos)
+	end := ip.codeSpanEnd(ip.pos, n)
+	if end < 0 {
+		ip.buffer = append(ip.buffer, ip.text[ip.pos:ip.pos+n]...)
+		ip.pos += n
+		return
+	}
+	code := strings.ReplaceAll(ip.text[ip.pos+n:end-n], "\n", " ")
+	if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.Trim(code, " ") != "" {
+		code = code[1 : len(code)-1]
+	}
+	ip.add(&markdownInline{kind: markdownCodeSpan, text: code})
+	ip.pos = end
+}
+
+// delimiter
+// @Description: Parse a run of * _ or ~, whether it can open or close emphasis depends on its neighbours.
+// @receiver ip
+func (ip *markdownInlineParser) delimiter() {
+	c := ip.text[ip.pos]
+	n := 0
+	for ip.pos+n < len(ip.text) && ip.text[ip.pos+n] == c {
+		n++
+	}
+	if c == '~' && n > 2 {
+		ip.buffer = append(ip.buffer, ip.text[ip.pos:ip.pos+n]...)
+		ip.pos += n
+		return
+	}
+	before, after := ' ', ' '
+	if ip.pos > 0 {
+		before, _ = utf8.DecodeLastRuneInString(ip.text[:ip.pos])
+	}
+	if ip.pos+n < len(ip.text) {
+		after, _ = utf8.DecodeRuneInString(ip.text[ip.pos+n:])
+	}
+	left := !unicode.IsSpace(after) && (!isMarkdownPunct(after) || unicode.IsSpace(before) || isMarkdownPunct(before))
+	right := !unicode.IsSpace(before) && (!isMarkdownPunct(before) || unicode.IsSpace(after) || isMarkdownPunct(after))
+	node := &markdownInline{kind: markdownDelimiter, char: c, count: n, original: n, canOpen: left, canClose: right}
+	if c == '_' {
+		node.canOpen = left && (!right || isMarkdownPunct(before))
+		node.canClose = right && (!left || isMarkdownPunct(after))
+	}
+	ip.add(node)
+	ip.pos += n
+}
+
+// link
+// @Description: Parse an inline link or image, [text](url "title"), or a reference [text][label], [text][]
+// or [text]. Nothing is parsed when the brackets do not form a link.
+// @receiver ip
+// @param image
+// @return bool
+func (ip *markdownInlineParser) link(image bool) bool {
+	open := ip.pos + 1
+	if image {
+		open++
+	}
+	depth := 1
+	closing := -1
+	for i := open; i < len(ip.text) && closing < 0; i++ {
+		switch ip.text[i] {
+		case '\\':
+			i++
+		case '`':
+			n := ip.backtickRun(i)
+			if end := ip.codeSpanEnd(i, n); end > 0 {
+				i = end - 1
+			} else {
+				i += n - 1
+			}
+		case '[':
+			depth++
+		case ']':
+			if depth--; depth == 0 {
+				closing = i
+			}
+		}
+	}
+	if closing < 0 {
+		return false
+	}
+	label := ip.text[open:closing]
+	url, title, end, ok := parseMarkdownDestination(ip.text, closing+1)
+	if !ok {
+		reference := label
+		end = closing + 1
+		if end < len(ip.text) && ip.text[end] == '[' {
+			if index := strings.IndexByte(ip.text[end:], ']'); index > 0 {
+				if index > 1 {
+					reference = ip.text[end+1 : end+index]
+				}
+				end += index + 1
+			}
+		}
+		var definition markdownReference
+		if definition, ok = ip.parser.references[normalizeMarkdownLabel(reference)]; !ok {
+			return false
+		}
+		url, title = definition.url, definition.title
+	}
+	node := &markdownInline{kind: markdownLink, url: url, title: title, children: ip.parser.parseInlines(label, ip.hardBreaks)}
+	if image {
+		node.kind = markdownImage
+	}
+	ip.add(node)
+	ip.pos = end
+	return true
+}
+
+// parseMarkdownDestination
+// @Description: Parse the (url "title") of an inline link.
+// @param text
+// @param pos the index of the (
+// @return url
+// @return title
+// @return end the index after the )
+// @return ok
+func parseMarkdownDestination(text string, pos int) (url string, title string, end int, ok bool) {
+	if pos >= len(text) || text[pos] != '(' {
+		return "", "", 0, false
+	}
+	skip := func(i int) int {
+		for i < len(text) && (text[i] == ' ' || text[i] == '\t' || text[i] == '\n') {
+			i++
+		}
+		return i
+	}
+	i := skip(pos + 1)
+	if i < len(text) && text[i] == '<' {
+		close := strings.IndexAny(text[i:], ">\n")
+		if close < 0 || text[i+close] != '>' {
+			return "", "", 0, false
+		}
+		url, i = text[i+1:i+close], i+close+1
+	} else {
+		start, depth := i, 0
+	scan:
+		for ; i < len(text); i++ {
+			switch c := text[i]; {
+			case c == '\\' && i+1 < len(text):
+				i++
+			case c <= ' ':
+				break scan
+			case c == '(':
+				depth++
+			case c == ')':
+				if depth == 0 {
+					break scan
+				}
+				depth--
+			}
+		}
+		url = text[start:i]
+	}
+	if titleStart := skip(i); titleStart > i && titleStart < len(text) && strings.IndexByte(`"'(`, text[titleStart]) >= 0 {
+		closer := text[titleStart]
+		if closer == '(' {
+			closer = ')'
+		}
+		j := titleStart + 1
+		for ; j < len(text) && text[j] != closer; j++ {
+			if text[j] == '\\' {
+				j++
+			}
+		}
+		if j >= len(text) {
+			return "", "", 0, false
+		}
+		title, i = text[titleStart+1:j], j+1
+	}
+	i = skip(i)
+	if i >= len(text) || text[i] != ')' {
+		return "", "", 0, false
+	}
+	return unescapeMarkdown(url), unescapeMarkdown(title), i + 1, true
+}
+
+// angle
+// @Description: Parse an autolink, <https://example.com> or <me@example.com>, or raw inline HTML.
+// @receiver ip
+// @return bool
+func (ip *markdownInlineParser) angle() bool {
+	rest := ip.text[ip.pos:]
+	if groups := markdownAutolink.FindStringSubmatch(rest); groups != nil {
+		ip.add(&markdownInline{kind: markdownLink, url: groups[1], children: []*markdownInline{{kind: markdownText, text: groups[1]}}})
+		ip.pos += len(groups[0])
+		return true
+	}
+	if groups := markdownEmailAutolink.FindStringSubmatch(rest); groups != nil {
+		ip.add(&markdownInline{kind: markdownLink, url: "mailto:" + groups[1], children: []*markdownInline{{kind: markdownText, text: groups[1]}}})
+		ip.pos += len(groups[0])
+		return true
+	}
+	if raw := markdownInlineHTML.FindString(rest); raw != "" {
+		ip.add(&markdownInline{kind: markdownRawHTML, text: raw})
+		ip.pos += len(raw)
+		return true
+	}
+	return false
+}
+
+// extendedAutolink
+// @Description: Parse a bare www. or http(s):// link or e-mail address at the start of a word, trailing
+// punctuation and unbalanced closing parentheses are left out of the link.
+// @receiver ip
+// @return bool
+func (ip *markdownInlineParser) extendedAutolink() bool {
+	if ip.pos > 0 && !strings.ContainsRune(" \t\n*_~(", rune(ip.text[ip.pos-1])) {
+		return false
+	}
+	rest := ip.text[ip.pos:]
+	link, url := markdownWebLink.FindString(rest), ""
+	if link != "" {
+		for {
+			trimmed := strings.TrimRight(link, `?!.,:*_~'"`)
+			if strings.HasSuffix(trimmed, ")") && strings.Count(trimmed, ")") > strings.Count(trimmed, "(") {
+				trimmed = trimmed[:len(trimmed)-1]
+			}
+			if trimmed == link {
+				break
+			}
+			link = trimmed
+		}
+		url = link
+		if strings.HasPrefix(link, "www.") {
+			url = "http://" + link
+		}
+	} else if c := rest[0]; isASCIILetter(c) || c >= '0' && c <= '9' {
+		link = strings.TrimRight(markdownEmailLink.FindString(rest), ".-_")
+		if link == "" || !strings.Contains(link, "@") || !strings.Contains(link[strings.IndexByte(link, '@'):], ".") {
+			return false
+		}
+		url = "mailto:" + link
+	}
+	if link == "" {
+		return false
+	}
+	ip.add(&markdownInline{kind: markdownLink, url: url, children: []*markdownInline{{kind: markdownText, text: link}}})
+	ip.pos += len(link)
+	return true
+}
+
+// processMarkdownEmphasis
+// @Description: Match the delimiter runs into emphasis, strong emphasis and strikethrough following the
+// CommonMark rules, runs left unmatched become text.
+// @param nodes
+// @return []*markdownInline
+func processMarkdownEmphasis(nodes []*markdownInline) []*markdownInline {
+	for c := 0; c < len(nodes); c++ {
+		closer := nodes[c]
+		if closer.kind != markdownDelimiter || !closer.canClose || closer.count == 0 {
+			continue
+		}
+		o := -1
+		for j := c - 1; j >= 0 && o < 0; j-- {
+			opener := nodes[j]
+			if opener.kind != markdownDelimiter || opener.char != closer.char || !opener.canOpen || opener.count == 0 {
+				continue
+			}
+			if closer.char == '~' {
+				if opener.count == closer.count {
+					o = j
+				}
+				continue
+			}
+			// the rule of three: a run that can both open and close only pairs when the lengths allow it
+			if (opener.canClose || closer.canOpen) && (opener.original+closer.original)%3 == 0 &&
+				!(opener.original%3 == 0 && closer.original%3 == 0) {
+				continue
+			}
+			o = j
+		}
+		if o < 0 {
+			continue
+		}
+		opener := nodes[o]
+		node := &markdownInline{kind: markdownEmphasis, children: append([]*markdownInline(nil), nodes[o+1:c]...)}
+		markdownDelimitersToText(node.children)
+		use := 1
+		switch {
+		case closer.char == '~':
+			node.kind, use = markdownStrike, closer.count
+		case opener.count >= 2 && closer.count >= 2:
+			node.kind, use = markdownStrong, 2
+		}
+		opener.count -= use
+		closer.count -= use
+		rebuilt := append(append(append([]*markdownInline(nil), nodes[:o+1]...), node), nodes[c:]...)
+		c = o + 2
+		if opener.count == 0 {
+			rebuilt = append(rebuilt[:o], rebuilt[o+1:]...)
+			c--
+		}
+		if closer.count == 0 {
+			rebuilt = append(rebuilt[:c], rebuilt[c+1:]...)
+		}
+		nodes = rebuilt
+		// look at the same closer again, or at what followed it
+		c--
+	}
+	markdownDelimitersToText(nodes)
+	return nodes
+}
+
+// markdownDelimitersToText
+// @Description: Turn the delimiter runs that were not matched into text.
+// @param nodes
+func markdownDelimitersToText(nodes []*markdownInline) {
+	for _, node := range nodes {
+		if node.kind == markdownDelimiter {
+			node.kind, node.text = markdownText, strings.Repeat(string(node.char), node.count)
+		}
+	}
+}
+
+// markdownRenderer
+// @Description: renders parsed Markdown as HTML.
+type markdownRenderer struct {
+	parser  *markdownParser
+	options MarkdownOptions
+	builder strings.Builder
+}
+
+// markdownSafeSchemes the URL schemes safe mode keeps in links and images.
+var markdownSafeSchemes = map[string]bool{"http": true, "https": true, "mailto": true, "tel": true}
+
+// url
+// @Description: Percent-encode a link destination, in safe mode an unsafe scheme gives no URL.
+// @receiver r
+// @param url
+// @return string
+// @return bool
+func (r *markdownRenderer) url(url string) (string, bool) {
+	if r.options.SafeMode && !isAllowedURL(url, markdownSafeSchemes) {
+		return "", false
+	}
+	var builder strings.Builder
+	for _, c := range []byte(url) {
+		if isASCIILetter(c) || c >= '0' && c <= '9' || strings.IndexByte("-_.!~*'();/?:@&=+$,%#[]", c) >= 0 {
+			builder.WriteByte(c)
+		} else {
+			builder.WriteString(fmt.Sprintf("%%%02X", c))
+		}
+	}
+	return builder.String(), true
+}
+
+// blocks
+// @Description: Render blocks.
+// @receiver r
+// @param blocks
+// @param tight render paragraphs without <p>, as in tight list items
+func (r *markdownRenderer) blocks(blocks []*markdownBlock, tight bool) {
+	for _, block := range blocks {
+		r.block(block, tight, "")
+	}
+}
+
+// block
+// @Description: Render a block.
+// @receiver r
+// @param block
+// @param tight
+// @param prefix HTML put before the content of a paragraph, such as a task list checkbox
+func (r *markdownRenderer) block(block *markdownBlock, tight bool, prefix string) {
+	b := &r.builder
+	switch block.kind {
+	case markdownParagraph:
+		if tight {
+			b.WriteString(prefix)
+			r.inlines(r.parser.parseInlines(block.text, r.options.HardBreaks))
+			return
+		}
+		b.WriteString("<p>" + prefix)
+		r.inlines(r.parser.parseInlines(block.text, r.options.HardBreaks))
+		b.WriteString("</p>\n")
+	case markdownHeading:
+		tag := "h" + strconv.Itoa(block.level)
+		b.WriteString("<" + tag + ">")
+		r.inlines(r.parser.parseInlines(block.text, false))
+		b.WriteString("</" + tag + ">\n")
+	case markdownThematicBreak:
+		b.WriteString("<hr />\n")
+	case markdownCode:
+		b.WriteString("<pre><code")
+		if block.info != "" {
+			b.WriteString(` class="language-` + E(block.info) + `"`)
+		}
+		b.WriteString(">" + E(block.text) + "</code></pre>\n")
+	case markdownHTML:
+		if r.options.SafeMode {
+			b.WriteString(E(block.text))
+		} else {
+			b.WriteString(block.text)
+		}
+	case markdownQuote:
+		b.WriteString("<blockquote>\n")
+		r.blocks(block.children, false)
+		b.WriteString("</blockquote>\n")
+	case markdownList:
+		tag := "ul"
+		if block.ordered {
+			tag = "ol"
+		}
+		b.WriteString("<" + tag)
+		if block.ordered && block.start != 1 {
+			b.WriteString(` start="` + strconv.Itoa(block.start) + `"`)
+		}
+		b.WriteString(">\n")
+		for _, item := range block.children {
+			r.item(item, block.tight)
+		}
+		b.WriteString("</" + tag + ">\n")
+	case markdownTable:
+		b.WriteString("<table>\n<thead>\n")
+		for i, row := range block.rows {
+			if i == 1 {
+				b.WriteString("<tbody>\n")
+			}
+			cell := "td"
+			if i == 0 {
+				cell = "th"
+			}
+			b.WriteString("<tr>\n")
+			for j, value := range row {
+				b.WriteString("<" + cell)
+				if block.align[j] != "" {
+					b.WriteString(` align="` + block.align[j] + `"`)
+				}
+				b.WriteString(">")
+				r.inlines(r.parser.parseInlines(value, false))
+				b.WriteString("</" + cell + ">\n")
+			}
+			b.WriteString("</tr>\n")
+			if i == 0 {
+				b.WriteString("</thead>\n")
+			}
+		}
+		if len(block.rows) > 1 {
+			b.WriteString("</tbody>\n")
+		}
+		b.WriteString("</table>\n")
+	}
+}
+
+// item
+// @Description: Render a list item, its paragraphs go without <p> in a tight list.
+// @receiver r
+// @param item
+// @param tight
+func (r *markdownRenderer) item(item *markdownBlock, tight bool) {
+	b := &r.builder
+	b.WriteString("<li>")
+	prefix := ""
+	switch item.task {
+	case 1:
+		prefix = `<input disabled="" type="checkbox" /> `
+	case 2:
+		prefix = `<input checked="" disabled="" type="checkbox" /> `
+	}
+	if len(item.children) == 0 || item.children[0].kind != markdownParagraph {
+		b.WriteString(strings.TrimSpace(prefix))
+		prefix = ""
+	}
+	for i, child := range item.children {
+		paragraph := tight && child.kind == markdownParagraph
+		if i == 0 && !paragraph {
+			b.WriteString("\n")
+		}
+		r.block(child, tight, prefix)
+		prefix = ""
+		if paragraph && i < len(item.children)-1 {
+			b.WriteString("\n")
+		}
+	}
+	b.WriteString("</li>\n")
+}
+
+// inlines
+// @Description: Render inlines.
+// @receiver r
+// @param nodes
+func (r *markdownRenderer) inlines(nodes []*markdownInline) {
+	b := &r.builder
+	for _, node := range nodes {
+		switch node.kind {
+		case markdownText:
+			b.WriteString(E(node.text))
+		case markdownCodeSpan:
+			b.WriteString("<code>" + E(node.text) + "</code>")
+		case markdownRawHTML:
+			if r.options.SafeMode {
+				b.WriteString(E(node.text))
+			} else {
+				b.WriteString(node.text)
+			}
+		case markdownSoftBreak:
+			b.WriteString("\n")
+		case markdownHardBreak:
+			b.WriteString("<br />\n")
+		case markdownEmphasis, markdownStrong, markdownStrike:
+			tag := map[markdownInlineKind]string{markdownEmphasis: "em", markdownStrong: "strong", markdownStrike: "del"}[node.kind]
+			b.WriteString("<" + tag + ">")
+			r.inlines(node.children)
+			b.WriteString("</" + tag + ">")
+		case markdownLink:
+			b.WriteString("<a")
+			if url, ok := r.url(node.url); ok {
+				b.WriteString(` href="` + E(url) + `"`)
+			}
+			if node.title != "" {
+				b.WriteString(` title="` + E(node.title) + `"`)
+			}
+			b.WriteString(">")
+			r.inlines(node.children)
+			b.WriteString("</a>")
+		case markdownImage:
+			b.WriteString("<img")
+			if url, ok := r.url(node.url); ok {
+				b.WriteString(` src="` + E(url) + `"`)
+			}
+			b.WriteString(` alt="` + E(markdownAlt(node.children)) + `"`)
+			if node.title != "" {
+				b.WriteString(` title="` + E(node.title) + `"`)
+			}
+			b.WriteString(" />")
+		}
+	}
+}
+
+// markdownAlt
+// @Description: Get the text of inlines, used as the alt text of an image.
+// @param nodes
+// @return string
+func markdownAlt(nodes []*markdownInline) string {
+	var builder strings.Builder
+	for _, node := range nodes {
+		switch node.kind {
+		case markdownText, markdownCodeSpan:
+			builder.WriteString(node.text)
+		case markdownSoftBreak, markdownHardBreak:
+			builder.WriteString(" ")
+		default:
+			builder.WriteString(markdownAlt(node.children))
+		}
+	}
+	return builder.String()
+}
+
+// markdownLines
+// @Description: Split a document into lines, accepting \r\n and \r line endings.
+// @param text
+// @return []string
+func markdownLines(text string) []string {
+	text = strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\r", "\n")
+	return strings.Split(strings.ReplaceAll(text, "\x00", "\uFFFD"), "\n")
+}
+
+// Markdown
+// @Description: Render CommonMark Markdown as HTML, with the GitHub extensions for tables, task lists,
+// autolinks of bare URLs and ~~strikethrough~~. Use SafeMode for text written by users.
+// @param text
+// @param options
+// @return string
+func Markdown(text string, options MarkdownOptions) string {
+	parser := &markdownParser{references: map[string]markdownReference{}}
+	renderer := &markdownRenderer{parser: parser, options: options}
+	renderer.blocks(parser.parseBlocks(markdownLines(text)), false)
+	return renderer.builder.String()
+}
+
+// InlineMarkdown
+// @Description: Render Markdown as HTML without blocks: emphasis, code, links and the like, but no
+// paragraphs, headings or lists, as for a single line such as a title.
+// @param text
+// @param options
+// @return string
+func InlineMarkdown(text string, options MarkdownOptions) string {
+	lines := markdownLines(text)
+	for i, line := range lines {
+		lines[i] = strings.TrimLeft(line, " \t")
+	}
+	parser := &markdownParser{references: map[string]markdownReference{}}
+	renderer := &markdownRenderer{parser: parser, options: options}
+	renderer.inlines(parser.parseInlines(strings.TrimRight(strings.Join(lines, "\n"), " \t\n"), options.HardBreaks))
+	return renderer.builder.String()
+}
+
+// MarkdownToPlainText
+// @Description: Render Markdown as readable text without markup, for channels such as SMS, see ToPlainText.
+// @param text
+// @return string
+func MarkdownToPlainText(text string) string {
+	return ToPlainText(Markdown(text, MarkdownOptions{}))
+}
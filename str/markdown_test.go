@@ -0,0 +1,118 @@
+package str
+
+import "testing"
+
+func BenchmarkMarkdown(t *testing.B) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "paragraphs", text: "Hello\nworld\n\nAgain", want: "<p>Hello\nworld</p>\n<p>Again</p>\n"},
+		{name: "atx headings", text: "# One\n### Three ###\n#hashtag", want: "<h1>One</h1>\n<h3>Three</h3>\n<p>#hashtag</p>\n"},
+		{name: "setext headings", text: "One\n===\nTwo\n---", want: "<h1>One</h1>\n<h2>Two</h2>\n"},
+		{name: "thematic break", text: "a\n\n* * *\nb", want: "<p>a</p>\n<hr />\n<p>b</p>\n"},
+		{name: "emphasis", text: "*a* _b_ **c** __d__ ***e*** *f **g** h*", want: "<p><em>a</em> <em>b</em> <strong>c</strong> <strong>d</strong> <em><strong>e</strong></em> <em>f <strong>g</strong> h</em></p>\n"},
+		{name: "intraword underscore", text: "snake_case_name and _x_y_", want: "<p>snake_case_name and <em>x_y</em></p>\n"},
+		{name: "unmatched", text: "2 * 3 * 4 and **open", want: "<p>2 * 3 * 4 and **open</p>\n"},
+		{name: "strikethrough", text: "~~gone~~ ~one~ ~~~three~~~", want: "<p><del>gone</del> <del>one</del> ~~~three~~~</p>\n"},
+		{name: "code span", text: "use `` a`b `` and `<br>`", want: "<p>use <code>a`b</code> and <code>&lt;br&gt;</code></p>\n"},
+		{name: "escapes and entities", text: `\*not\* &copy; &amp; &nope; 1 < 2`, want: "<p>*not* © &amp; &amp;nope; 1 &lt; 2</p>\n"},
+		{name: "line breaks", text: "a  \nb\\\nc", want: "<p>a<br />\nb<br />\nc</p>\n"},
+		{name: "links", text: `[a](/x "T") [b](<my url>) ![c *d*](i.png) <https://e.io> <f@g.io>`,
+			want: `<p><a href="/x" title="T">a</a> <a href="my%20url">b</a> <img src="i.png" alt="c d" /> <a href="https://e.io">https://e.io</a> <a href="mailto:f@g.io">f@g.io</a></p>` + "\n"},
+		{name: "reference links", text: "[a][x] [X][] [x]\n\n[x]: /url 'T'", want: `<p><a href="/url" title="T">a</a> <a href="/url" title="T">X</a> <a href="/url" title="T">x</a></p>` + "\n"},
+		{name: "not a link", text: "[a] [b](", want: "<p>[a] [b](</p>\n"},
+		{name: "autolinks", text: "www.a.io, https://b.io/x_(y)). c@d.io!", want: `<p><a href="http://www.a.io">www.a.io</a>, <a href="https://b.io/x_(y)">https://b.io/x_(y)</a>). <a href="mailto:c@d.io">c@d.io</a>!</p>` + "\n"},
+		{name: "fenced code", text: "```go\nfunc() {\n\treturn\n}\n```", want: "<pre><code class=\"language-go\">func() {\n\treturn\n}\n</code></pre>\n"},
+		{name: "unclosed fence", text: "~~~\n<b>", want: "<pre><code>&lt;b&gt;\n</code></pre>\n"},
+		{name: "indented code", text: "    a\n\n    b\n", want: "<pre><code>a\n\nb\n</code></pre>\n"},
+		{name: "blockquote", text: "> a\nb\n> > c", want: "<blockquote>\n<p>a\nb</p>\n<blockquote>\n<p>c</p>\n</blockquote>\n</blockquote>\n"},
+		{name: "tight list", text: "- a\n- b\n  - c\n- d", want: "<ul>\n<li>a</li>\n<li>b\n<ul>\n<li>c</li>\n</ul>\n</li>\n<li>d</li>\n</ul>\n"},
+		{name: "loose list", text: "1. a\n\n2. b", want: "<ol>\n<li>\n<p>a</p>\n</li>\n<li>\n<p>b</p>\n</li>\n</ol>\n"},
+		{name: "ordered start", text: "3) a\n4) b", want: "<ol start=\"3\">\n<li>a</li>\n<li>b</li>\n</ol>\n"},
+		{name: "list ends", text: "- a\n\ntext", want: "<ul>\n<li>a</li>\n</ul>\n<p>text</p>\n"},
+		{name: "empty item", text: "1.\n\ntext", want: "<ol>\n<li></li>\n</ol>\n<p>text</p>\n"},
+		{name: "number does not start a list", text: "in\n2024. a year", want: "<p>in\n2024. a year</p>\n"},
+		{name: "task list", text: "- [ ] a\n- [x] b", want: "<ul>\n<li><input disabled=\"\" type=\"checkbox\" /> a</li>\n<li><input checked=\"\" disabled=\"\" type=\"checkbox\" /> b</li>\n</ul>\n"},
+		{name: "table", text: "| a | b | c |\n|:--|:-:|--:|\n| 1 | `x \\| y` |\n",
+			want: "<table>\n<thead>\n<tr>\n<th align=\"left\">a</th>\n<th align=\"center\">b</th>\n<th align=\"right\">c</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td align=\"left\">1</td>\n<td align=\"center\"><code>x | y</code></td>\n<td align=\"right\"></td>\n</tr>\n</tbody>\n</table>\n"},
+		{name: "raw html", text: "<div>\n*a*\n</div>\n\n<span>*b*</span>", want: "<div>\n*a*\n</div>\n<p><span><em>b</em></span></p>\n"},
+		{name: "crlf", text: "a\r\nb\r\n", want: "<p>a\nb</p>\n"},
+		{name: "empty", text: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Markdown(tt.text, MarkdownOptions{}); got != tt.want {
+				t.Errorf("Markdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkMarkdownOptions(t *testing.B) {
+	tests := []struct {
+		name    string
+		text    string
+		options MarkdownOptions
+		want    string
+	}{
+		{name: "safe html block", text: "<script>alert(1)</script>", options: MarkdownOptions{SafeMode: true},
+			want: "&lt;script&gt;alert(1)&lt;/script&gt;\n"},
+		{name: "safe inline html", text: "a <img src=x onerror=alert(1)>", options: MarkdownOptions{SafeMode: true},
+			want: "<p>a &lt;img src=x onerror=alert(1)&gt;</p>\n"},
+		{name: "safe links", text: "[a](javascript:alert(1)) [b](JAVA&#9;SCRIPT:x) [c](/ok) ![d](data:text/html,x)", options: MarkdownOptions{SafeMode: true},
+			want: "<p><a>a</a> <a>b</a> <a href=\"/ok\">c</a> <img alt=\"d\" /></p>\n"},
+		{name: "unsafe allowed", text: "[a](javascript:x)", want: "<p><a href=\"javascript:x\">a</a></p>\n"},
+		{name: "hard breaks", text: "a\nb", options: MarkdownOptions{HardBreaks: true}, want: "<p>a<br />\nb</p>\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Markdown(tt.text, tt.options); got != tt.want {
+				t.Errorf("Markdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkInlineMarkdown(t *testing.B) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "inline", text: "**Hi** [you](/me)", want: "<strong>Hi</strong> <a href=\"/me\">you</a>"},
+		{name: "no blocks", text: "# not a heading\n- not a list", want: "# not a heading\n- not a list"},
+		{name: "escaped", text: "<b>&", want: "<b>&amp;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := InlineMarkdown(tt.text, MarkdownOptions{}); got != tt.want {
+				t.Errorf("InlineMarkdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkMarkdownToPlainText(t *testing.B) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "message", text: "# Order shipped\n\nHi **Ann**, your order\nis on its way. [Track it](https://t.io/1).",
+			want: "Order shipped\n\nHi Ann, your order is on its way. Track it (https://t.io/1)."},
+		{name: "lists", text: "- [x] paid\n- [ ] shipped\n\n1. one\n2. two", want: "- [x] paid\n- [ ] shipped\n\n1. one\n2. two"},
+		{name: "table", text: "| a | b |\n|---|---|\n| 1 | 2 |", want: "a\tb\n1\t2"},
+		{name: "code", text: "Run:\n\n    go test\n\nthen `go vet`", want: "Run:\n\ngo test\n\nthen go vet"},
+		{name: "entities", text: "Tom &amp; Jerry &lt;3", want: "Tom & Jerry <3"},
+		{name: "loose list", text: "1. Open the app\n\n2. Tap *Pay*", want: "1. Open the app\n\n2. Tap Pay"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := MarkdownToPlainText(tt.text); got != tt.want {
+				t.Errorf("MarkdownToPlainText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package str
+
+import (
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// inflectionRule
+// @Description: a regular expression rule turning a lower case word into its other form.
+type inflectionRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// inflector
+// @Description: English inflection rules, guarded so rules can be registered at runtime.
+type inflector struct {
+	mutex         sync.RWMutex
+	plural        []inflectionRule
+	singular      []inflectionRule
+	irregular     map[string]string // singular => plural
+	irregularBack map[string]string // plural => singular
+	uncountable   map[string]bool
+}
+
+// defaultInflector the inflector used by Plural and Singular.
+var defaultInflector = newInflector()
+
+// newInflector
+// @Description: construct an inflector with the built-in English rules.
+// @return *inflector
+func newInflector() *inflector {
+	i := &inflector{
+		irregular:     map[string]string{},
+		irregularBack: map[string]string{},
+		uncountable:   map[string]bool{},
+	}
+	plural := [][2]string{
+		{`(quiz)$`, "${1}zes"},
+		{`^(oxen)$`, "${1}"},
+		{`^(ox)$`, "${1}en"},
+		{`^(m|l)ice$`, "${1}ice"},
+		{`^(m|l)ouse$`, "${1}ice"},
+		{`(matr|vert|ind)(?:ix|ex)$`, "${1}ices"},
+		{`(x|ch|ss|sh)$`, "${1}es"},
+		{`([^aeiouy]|qu)y$`, "${1}ies"},
+		{`(hive)$`, "${1}s"},
+		{`(?:([^f])fe|([lr])f)$`, "${1}${2}ves"},
+		{`sis$`, "ses"},
+		{`([ti])a$`, "${1}a"},
+		{`([ti])um$`, "${1}a"},
+		{`(buffal|tomat|potat|her|ech)o$`, "${1}oes"},
+		{`(bu)s$`, "${1}ses"},
+		{`(alias|status|campus)$`, "${1}es"},
+		{`(octop|vir)i$`, "${1}i"},
+		{`(octop|vir)us$`, "${1}i"},
+		{`^(ax|test)is$`, "${1}es"},
+		{`s$`, "s"},
+		{`$`, "s"},
+	}
+	singular := [][2]string{
+		{`(database)s$`, "${1}"},
+		{`(quiz)zes$`, "${1}"},
+		{`(matr)ices$`, "${1}ix"},
+		{`(vert|ind)ices$`, "${1}ex"},
+		{`^(ox)en`, "${1}"},
+		{`(alias|status|campus)(es)?$`, "${1}"},
+		{`(octop|vir)(us|i)$`, "${1}us"},
+		{`^(a)x[ie]s$`, "${1}xis"},
+		{`(cris|test)(is|es)$`, "${1}is"},
+		{`(shoe)s$`, "${1}"},
+		{`(o)es$`, "${1}"},
+		{`(bus)(es)?$`, "${1}"},
+		{`^(m|l)ice$`, "${1}ouse"},
+		{`(x|ch|ss|sh)es$`, "${1}"},
+		{`(m)ovies$`, "${1}ovie"},
+		{`(s)eries$`, "${1}eries"},
+		{`([^aeiouy]|qu)ies$`, "${1}y"},
+		{`([lr])ves$`, "${1}f"},
+		{`(tive)s$`, "${1}"},
+		{`(hive)s$`, "${1}"},
+		{`([^f])ves$`, "${1}fe"},
+		{`(^analy)(sis|ses)$`, "${1}sis"},
+		{`((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$`, "${1}sis"},
+		{`([ti])a$`, "${1}um"},
+		{`(n)ews$`, "${1}ews"},
+		{`(ss)$`, "${1}"},
+		{`s$`, ""},
+	}
+	for _, rule := range plural {
+		i.plural = append(i.plural, inflectionRule{regexp.MustCompile(rule[0]), rule[1]})
+	}
+	for _, rule := range singular {
+		i.singular = append(i.singular, inflectionRule{regexp.MustCompile(rule[0]), rule[1]})
+	}
+	irregular := map[string]string{
+		"alumnus": "alumni", "cactus": "cacti", "child": "children", "criterion": "criteria",
+		"die": "dice", "foot": "feet", "genus": "genera", "goose": "geese", "leaf": "leaves",
+		"man": "men", "move": "moves", "person": "people", "phenomenon": "phenomena",
+		"radius": "radii", "sex": "sexes", "thief": "thieves", "tooth": "teeth",
+		"woman": "women", "zombie": "zombies", "cookie": "cookies", "movie": "movies",
+	}
+	for single, plural := range irregular {
+		i.irregular[single] = plural
+		i.irregularBack[plural] = single
+	}
+	for _, word := range []string{
+		"audio", "bison", "cattle", "chassis", "compensation", "data", "deer", "education",
+		"emoji", "equipment", "evidence", "feedback", "firmware", "fish", "furniture", "gold",
+		"hardware", "information", "jedi", "kin", "knowledge", "love", "metadata", "money",
+		"moose", "news", "nutrition", "offspring", "plankton", "pokemon", "police", "rain",
+		"rice", "series", "sheep", "software", "species", "swine", "traffic", "wheat",
+	} {
+		i.uncountable[word] = true
+	}
+	return i
+}
+
+// inflect
+// @Description: Convert a single lower case word using the irregular table or the first matching rule.
+// @receiver i
+// @param word
+// @param isPlural
+// @return string
+func (i *inflector) inflect(word string, isPlural bool) string {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	if i.uncountable[word] {
+		return word
+	}
+	irregular, rules := i.irregularBack, i.singular
+	if isPlural {
+		irregular, rules = i.irregular, i.plural
+	}
+	if response, ok := irregular[word]; ok {
+		return response
+	}
+	// an irregular word given in the form asked for is already inflected
+	if isPlural {
+		if _, ok := i.irregularBack[word]; ok {
+			return word
+		}
+	} else if _, ok := i.irregular[word]; ok {
+		return word
+	}
+	for _, rule := range rules {
+		if rule.pattern.MatchString(word) {
+			return rule.pattern.ReplaceAllString(word, rule.replacement)
+		}
+	}
+	return word
+}
+
+// splitLastWord
+// @Description: Split an identifier before its last word, at a separator or a studly case boundary.
+// @param value
+// @return prefix
+// @return word
+func splitLastWord(value string) (prefix string, word string) {
+	runes := []rune(value)
+	start := len(runes)
+	for start > 0 && unicode.IsLetter(runes[start-1]) {
+		start--
+	}
+	for i := len(runes) - 2; i > start; i-- {
+		if unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i+1]) {
+			start = i
+			break
+		}
+	}
+	return string(runes[:start]), string(runes[start:])
+}
+
+// inflectPreservingCase
+// @Description: Inflect the last word of the value and keep the casing of the given word.
+// @param value
+// @param isPlural
+// @return string
+func inflectPreservingCase(value string, isPlural bool) string {
+	prefix, word := splitLastWord(value)
+	if word == "" {
+		return value
+	}
+	lower := strings.ToLower(word)
+	inflected := defaultInflector.inflect(lower, isPlural)
+	wordRunes, lowerRunes, inflectedRunes := []rune(word), []rune(lower), []rune(inflected)
+	common := 0
+	for common < len(lowerRunes) && common < len(inflectedRunes) && lowerRunes[common] == inflectedRunes[common] {
+		common++
+	}
+	suffix := string(inflectedRunes[common:])
+	// an acronym takes a lower case suffix as studlyWord writes it (URLs), other upper case words an
+	// upper case one
+	if len(wordRunes) > 1 && strings.ToUpper(word) == word && !IsAcronym(word) {
+		suffix = strings.ToUpper(suffix)
+	} else if common == 0 && unicode.IsUpper(wordRunes[0]) {
+		suffix = UcFirst(suffix)
+	}
+	return prefix + string(wordRunes[:common]) + suffix
+}
+
+// Plural
+// @Description: Get the plural form of an English word, the last word of a multi-word identifier
+// is inflected and the casing of the input is kept. A count of 1 or -1 returns the value unchanged.
+// @param value
+// @param count
+// @return string
+func Plural(value string, count int) string {
+	if count == 1 || count == -1 {
+		return value
+	}
+	return inflectPreservingCase(value, true)
+}
+
+// Singular
+// @Description: Get the singular form of an English word.
+// @param value
+// @return string
+func Singular(value string) string {
+	return inflectPreservingCase(value, false)
+}
+
+// PluralStudly
+// @Description: Pluralize the last word of an identifier and convert it to studly caps case.
+// @param value
+// @param count
+// @return string
+func PluralStudly(value string, count int) string {
+	return Plural(Studly(value), count)
+}
+
+// RegisterIrregular
+// @Description: Register a word whose plural does not follow the rules.
+// @param singular
+// @param plural
+func RegisterIrregular(singular string, plural string) {
+	defaultInflector.mutex.Lock()
+	defer defaultInflector.mutex.Unlock()
+	singular, plural = strings.ToLower(singular), strings.ToLower(plural)
+	defaultInflector.irregular[singular] = plural
+	defaultInflector.irregularBack[plural] = singular
+}
+
+// RegisterUncountable
+// @Description: Register words that have the same singular and plural form.
+// @param words
+func RegisterUncountable(words ...string) {
+	defaultInflector.mutex.Lock()
+	defer defaultInflector.mutex.Unlock()
+	for _, word := range words {
+		defaultInflector.uncountable[strings.ToLower(word)] = true
+	}
+}
+
+// compileInflectionRule
+// @Description: Compile a custom rule, panic with an invalid param error for a bad pattern.
+// @param pattern
+// @param replacement
+// @return inflectionRule
+func compileInflectionRule(pattern string, replacement string) inflectionRule {
+	reg, e := regexp.Compile(pattern)
+	if e != nil {
+		panic(exceptions.NewInvalidParamErrorWithData(
+			fmt.Sprintf("invalid inflection pattern:%s", pattern), e.Error(),
+		))
+	}
+	return inflectionRule{pattern: reg, replacement: replacement}
+}
+
+// RegisterPluralRule
+// @Description: Register a plural rule applied to lower case words before the built-in rules.
+// @param pattern
+// @param replacement uses ${1} style references
+func RegisterPluralRule(pattern string, replacement string) {
+	rule := compileInflectionRule(pattern, replacement)
+	defaultInflector.mutex.Lock()
+	defer defaultInflector.mutex.Unlock()
+	defaultInflector.plural = append([]inflectionRule{rule}, defaultInflector.plural...)
+}
+
+// RegisterSingularRule
+// @Description: Register a singular rule applied to lower case words before the built-in rules.
+// @param pattern
+// @param replacement uses ${1} style references
+func RegisterSingularRule(pattern string, replacement string) {
+	rule := compileInflectionRule(pattern, replacement)
+	defaultInflector.mutex.Lock()
+	defer defaultInflector.mutex.Unlock()
+	defaultInflector.singular = append([]inflectionRule{rule}, defaultInflector.singular...)
+}
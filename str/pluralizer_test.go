@@ -0,0 +1,127 @@
+package str
+
+import (
+	"testing"
+)
+
+func BenchmarkPlural(t *testing.B) {
+	tests := []struct {
+		value string
+		count int
+		want  string
+	}{
+		{value: "user", count: 2, want: "users"},
+		{value: "user", count: 1, want: "user"},
+		{value: "user", count: 0, want: "users"},
+		{value: "USER", count: 2, want: "USERS"},
+		{value: "Child", count: 2, want: "Children"},
+		{value: "person", count: 2, want: "people"},
+		{value: "Person", count: 2, want: "People"},
+		{value: "MOUSE", count: 2, want: "MICE"},
+		{value: "category", count: 2, want: "categories"},
+		{value: "box", count: 2, want: "boxes"},
+		{value: "wife", count: 2, want: "wives"},
+		{value: "analysis", count: 2, want: "analyses"},
+		{value: "status", count: 2, want: "statuses"},
+		{value: "sheep", count: 2, want: "sheep"},
+		{value: "Information", count: 2, want: "Information"},
+		{value: "children", count: 2, want: "children"},
+		{value: "UserProfile", count: 2, want: "UserProfiles"},
+		{value: "UserChild", count: 2, want: "UserChildren"},
+		{value: "HTTPServer", count: 2, want: "HTTPServers"},
+		{value: "user_category", count: 2, want: "user_categories"},
+		{value: "URL", count: 2, want: "URLs"},
+		{value: "UserID", count: 2, want: "UserIDs"},
+		{value: "user_URL", count: 2, want: "user_URLs"},
+		{value: "", count: 2, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.B) {
+			if got := Plural(tt.value, tt.count); got != tt.want {
+				t.Errorf("Plural() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSingular(t *testing.B) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "users", want: "user"},
+		{value: "USERS", want: "USER"},
+		{value: "Children", want: "Child"},
+		{value: "people", want: "person"},
+		{value: "categories", want: "category"},
+		{value: "boxes", want: "box"},
+		{value: "wives", want: "wife"},
+		{value: "analyses", want: "analysis"},
+		{value: "statuses", want: "status"},
+		{value: "status", want: "status"},
+		{value: "class", want: "class"},
+		{value: "news", want: "news"},
+		{value: "user", want: "user"},
+		{value: "UserProfiles", want: "UserProfile"},
+		{value: "URLs", want: "URL"},
+		{value: "UserAPIs", want: "UserAPI"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.B) {
+			if got := Singular(tt.value); got != tt.want {
+				t.Errorf("Singular() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkPluralStudly(t *testing.B) {
+	tests := []struct {
+		value string
+		count int
+		want  string
+	}{
+		{value: "user_profile", count: 2, want: "UserProfiles"},
+		{value: "user-child", count: 3, want: "UserChildren"},
+		{value: "user_profile", count: 1, want: "UserProfile"},
+		{value: "api", count: 2, want: "APIs"},
+		{value: "id", count: 2, want: "IDs"},
+		{value: "user_url", count: 2, want: "UserURLs"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.B) {
+			if got := PluralStudly(tt.value, tt.count); got != tt.want {
+				t.Errorf("PluralStudly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkRegisterInflection(t *testing.B) {
+	t.Run("register", func(t *testing.B) {
+		RegisterIrregular("gizmo", "gizmata")
+		RegisterUncountable("Aircraft")
+		RegisterPluralRule(`(bureau)$`, "${1}x")
+		RegisterSingularRule(`(bureau)x$`, "${1}")
+		if got := Plural("Gizmo", 2); got != "Gizmata" {
+			t.Errorf("Plural() = %v, want %v", got, "Gizmata")
+		}
+		if got := Singular("gizmata"); got != "gizmo" {
+			t.Errorf("Singular() = %v, want %v", got, "gizmo")
+		}
+		if got := Plural("aircraft", 2); got != "aircraft" {
+			t.Errorf("Plural() = %v, want %v", got, "aircraft")
+		}
+		if got, back := Plural("bureau", 2), Singular("bureaux"); got != "bureaux" || back != "bureau" {
+			t.Errorf("Plural(), Singular() = %v %v, want %v %v", got, back, "bureaux", "bureau")
+		}
+	})
+	t.Run("invalid pattern", func(t *testing.B) {
+		defer func() {
+			if err := recover(); err == nil {
+				t.Errorf("RegisterPluralRule() want panic")
+			}
+		}()
+		RegisterPluralRule(`(`, "")
+	})
+}
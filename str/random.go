@@ -0,0 +1,146 @@
+package str
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"sync"
+)
+
+var (
+	randomStringMutex   sync.RWMutex
+	randomStringFactory func(length int) string
+)
+
+// Character classes used by Password.
+const (
+	passwordLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	passwordNumbers = "0123456789"
+	passwordSymbols = "~!#$%^&*()-_.,<>?/\\{}[]|:;"
+	passwordSpaces  = " "
+)
+
+// secureSource
+// @Description: reads crypto/rand in blocks and hands out unbiased integers.
+type secureSource struct {
+	buffer []byte
+}
+
+// intn
+// @Description: Get a uniform integer in [0, n) by rejection sampling, so no value is favoured by a modulo.
+// @receiver s
+// @param n
+// @return int
+func (s *secureSource) intn(n int) int {
+	limit := uint64(1<<32) - uint64(1<<32)%uint64(n)
+	for {
+		if len(s.buffer) < 4 {
+			s.buffer = make([]byte, 256)
+			if _, e := rand.Read(s.buffer); e != nil {
+				panic(e)
+			}
+		}
+		value := uint64(binary.BigEndian.Uint32(s.buffer))
+		s.buffer = s.buffer[4:]
+		if value < limit {
+			return int(value % uint64(n))
+		}
+	}
+}
+
+// SecureRandom
+// @Description: Generate a random string from a cryptographically secure source, every character of the
+// alphabet is equally likely.
+// @param length
+// @param alphabet characters to choose from, may contain multi-byte characters
+// @return string
+func SecureRandom(length int, alphabet string) string {
+	characters := []rune(alphabet)
+	if len(characters) == 0 {
+		panic(exceptions.NewInvalidParamError("random alphabet must not be empty"))
+	}
+	if length <= 0 {
+		return ""
+	}
+	source := secureSource{}
+	response := make([]rune, length)
+	for i := range response {
+		response[i] = characters[source.intn(len(characters))]
+	}
+	return string(response)
+}
+
+// Password
+// @Description: Generate a random, secure password that contains at least one character of every enabled class.
+// @param length
+// @param letters
+// @param numbers
+// @param symbols
+// @param spaces
+// @return string
+func Password(length int, letters bool, numbers bool, symbols bool, spaces bool) string {
+	var classes []string
+	for class, enabled := range map[string]bool{
+		passwordLetters: letters, passwordNumbers: numbers, passwordSymbols: symbols, passwordSpaces: spaces,
+	} {
+		if enabled {
+			classes = append(classes, class)
+		}
+	}
+	if len(classes) == 0 {
+		panic(exceptions.NewInvalidParamError("password needs at least one character class"))
+	}
+	if length < len(classes) {
+		panic(exceptions.NewInvalidParamError(
+			fmt.Sprintf("password length %d is shorter than the %d enabled character classes", length, len(classes)),
+		))
+	}
+	all := ""
+	response := make([]rune, 0, length)
+	for _, class := range classes {
+		all += class
+		response = append(response, []rune(SecureRandom(1, class))...)
+	}
+	response = append(response, []rune(SecureRandom(length-len(classes), all))...)
+	source := secureSource{}
+	for i := len(response) - 1; i > 0; i-- {
+		j := source.intn(i + 1)
+		response[i], response[j] = response[j], response[i]
+	}
+	return string(response)
+}
+
+// CreateRandomStringsUsing
+// @Description: Set the callback used by Random, nil restores normal generation.
+// @param factory
+func CreateRandomStringsUsing(factory func(length int) string) {
+	randomStringMutex.Lock()
+	defer randomStringMutex.Unlock()
+	randomStringFactory = factory
+}
+
+// CreateRandomStringsUsingSequence
+// @Description: Return the given strings from Random in order, then values from whenMissing or newly generated ones.
+// @param values
+// @param whenMissing
+func CreateRandomStringsUsingSequence(values []string, whenMissing func(length int) string) {
+	if whenMissing == nil {
+		whenMissing = func(length int) string {
+			return SecureRandom(length, alphaNumericBytes)
+		}
+	}
+	next := sequence(values)
+	CreateRandomStringsUsing(func(length int) string {
+		if value, ok := next(); ok {
+			return value
+		}
+		return whenMissing(length)
+	})
+}
+
+// CreateRandomStringsNormally
+// @Description: Restore normal random string generation.
+func CreateRandomStringsNormally() {
+	CreateRandomStringsUsing(nil)
+}
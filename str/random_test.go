@@ -0,0 +1,125 @@
+package str
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func BenchmarkSecureRandom(t *testing.B) {
+	type args struct {
+		length   int
+		alphabet string
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{name: "hex", args: args{length: 32, alphabet: "0123456789abcdef"}},
+		{name: "unicode", args: args{length: 8, alphabet: "中文字"}},
+		{name: "zero", args: args{length: 0, alphabet: "ab"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			got := SecureRandom(tt.args.length, tt.args.alphabet)
+			if utf8.RuneCountInString(got) != tt.args.length {
+				t.Errorf("SecureRandom() = %v, want length %v", got, tt.args.length)
+			}
+			for _, c := range got {
+				if !strings.ContainsRune(tt.args.alphabet, c) {
+					t.Errorf("SecureRandom() = %v contains %q outside the alphabet", got, c)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSecureRandomUniform(t *testing.B) {
+	t.Run("uniform", func(t *testing.B) {
+		// 3 does not divide 2^32, a modulo without rejection would still pass but must stay close
+		counts := map[rune]int{}
+		for _, c := range SecureRandom(30000, "abc") {
+			counts[c]++
+		}
+		for c, count := range counts {
+			if count < 9000 || count > 11000 {
+				t.Errorf("SecureRandom() picked %q %d times out of 30000", c, count)
+			}
+		}
+	})
+	t.Run("empty alphabet", func(t *testing.B) {
+		defer func() {
+			if err := recover(); err == nil {
+				t.Errorf("SecureRandom() want panic")
+			}
+		}()
+		SecureRandom(3, "")
+	})
+}
+
+func BenchmarkPassword(t *testing.B) {
+	type args struct {
+		length  int
+		letters bool
+		numbers bool
+		symbols bool
+		spaces  bool
+	}
+	tests := []struct {
+		name string
+		args args
+	}{
+		{name: "all", args: args{length: 4, letters: true, numbers: true, symbols: true, spaces: true}},
+		{name: "letters numbers", args: args{length: 32, letters: true, numbers: true}},
+		{name: "numbers", args: args{length: 6, numbers: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			for i := 0; i < 50; i++ {
+				got := Password(tt.args.length, tt.args.letters, tt.args.numbers, tt.args.symbols, tt.args.spaces)
+				if len(got) != tt.args.length {
+					t.Errorf("Password() = %v, want length %v", got, tt.args.length)
+				}
+				classes := map[string]bool{
+					passwordLetters: tt.args.letters, passwordNumbers: tt.args.numbers,
+					passwordSymbols: tt.args.symbols, passwordSpaces: tt.args.spaces,
+				}
+				for class, enabled := range classes {
+					if strings.ContainsAny(got, class) != enabled {
+						t.Errorf("Password() = %q, class %q enabled %v", got, class, enabled)
+					}
+				}
+			}
+		})
+	}
+	t.Run("too short", func(t *testing.B) {
+		defer func() {
+			if err := recover(); err == nil {
+				t.Errorf("Password() want panic")
+			}
+		}()
+		Password(2, true, true, true, false)
+	})
+}
+
+func BenchmarkCreateRandomStringsUsing(t *testing.B) {
+	t.Run("factory", func(t *testing.B) {
+		defer CreateRandomStringsNormally()
+		CreateRandomStringsUsing(func(length int) string {
+			return strings.Repeat("x", length)
+		})
+		if got := Random(3); got != "xxx" {
+			t.Errorf("Random() = %v, want %v", got, "xxx")
+		}
+	})
+	t.Run("sequence", func(t *testing.B) {
+		defer CreateRandomStringsNormally()
+		CreateRandomStringsUsingSequence([]string{"first"}, nil)
+		if got := Random(5); got != "first" {
+			t.Errorf("Random() = %v, want %v", got, "first")
+		}
+		if got := Random(5); len(got) != 5 || got == "first" {
+			t.Errorf("Random() = %v, want a generated value", got)
+		}
+	})
+}
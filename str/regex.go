@@ -0,0 +1,318 @@
+package str
+
+import (
+	"container/list"
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// PatternFlag changes how a pattern matches, flags are combined with |.
+type PatternFlag int
+
+const (
+	PatternIgnoreCase PatternFlag = 1 << iota // letters match in either case, (?i)
+	PatternMultiline                          // ^ and $ match at line breaks, (?m)
+	PatternDotAll                             // . matches line breaks, (?s)
+)
+
+// prefix
+// @Description: Get the inline flags a pattern starts with for the given flags.
+// @receiver f
+// @return string
+func (f PatternFlag) prefix() string {
+	flags := ""
+	if f&PatternIgnoreCase != 0 {
+		flags += "i"
+	}
+	if f&PatternMultiline != 0 {
+		flags += "m"
+	}
+	if f&PatternDotAll != 0 {
+		flags += "s"
+	}
+	if flags == "" {
+		return ""
+	}
+	return "(?" + flags + ")"
+}
+
+// combineFlags
+// @Description: Combine optional flags into one.
+// @param flags
+// @return response
+func combineFlags(flags []PatternFlag) (response PatternFlag) {
+	for _, flag := range flags {
+		response |= flag
+	}
+	return response
+}
+
+// regexCacheEntry a compiled pattern in the cache list.
+type regexCacheEntry struct {
+	pattern string
+	reg     *regexp.Regexp
+}
+
+// regexCache
+// @Description: least recently used cache of compiled patterns, safe for concurrent use.
+type regexCache struct {
+	mutex    sync.Mutex
+	capacity int
+	order    *list.List // most recently used first
+	entries  map[string]*list.Element
+}
+
+// defaultRegexCache the cache used by every pattern helper of the package.
+var defaultRegexCache = &regexCache{capacity: 512, order: list.New(), entries: map[string]*list.Element{}}
+
+// compile
+// @Description: Get the compiled pattern from the cache, or compile and cache it. Bad patterns are not cached.
+// @receiver c
+// @param pattern
+// @return *regexp.Regexp
+// @return error
+func (c *regexCache) compile(pattern string) (*regexp.Regexp, error) {
+	c.mutex.Lock()
+	if element, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(element)
+		c.mutex.Unlock()
+		return element.Value.(*regexCacheEntry).reg, nil
+	}
+	c.mutex.Unlock()
+	reg, e := regexp.Compile(pattern)
+	if e != nil {
+		return nil, e
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.entries[pattern]; ok {
+		return element.Value.(*regexCacheEntry).reg, nil
+	}
+	c.entries[pattern] = c.order.PushFront(&regexCacheEntry{pattern: pattern, reg: reg})
+	c.evict()
+	return reg, nil
+}
+
+// evict
+// @Description: Drop the least recently used patterns beyond the capacity, the caller holds the lock.
+// @receiver c
+func (c *regexCache) evict() {
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*regexCacheEntry).pattern)
+	}
+}
+
+// SetRegexCacheSize
+// @Description: Set how many compiled patterns are kept, 0 turns the cache off.
+// @param size
+func SetRegexCacheSize(size int) {
+	if size < 0 {
+		panic(exceptions.NewInvalidParamError(fmt.Sprintf("regex cache size can not be negative:%d", size)))
+	}
+	defaultRegexCache.mutex.Lock()
+	defer defaultRegexCache.mutex.Unlock()
+	defaultRegexCache.capacity = size
+	defaultRegexCache.evict()
+}
+
+// compilePattern
+// @Description: Compile a pattern with flags through the shared cache.
+// @param pattern
+// @param flags
+// @return *regexp.Regexp
+// @return error an InvalidParamError for a bad pattern
+func compilePattern(pattern string, flags PatternFlag) (*regexp.Regexp, error) {
+	reg, e := defaultRegexCache.compile(flags.prefix() + pattern)
+	if e != nil {
+		return nil, exceptions.NewInvalidParamErrorWithData(fmt.Sprintf("invalid pattern:%s", pattern), e.Error())
+	}
+	return reg, nil
+}
+
+// mustCompilePattern
+// @Description: Compile a pattern through the shared cache, panicking with an InvalidParamError when it
+// is invalid.
+// @param pattern
+// @return *regexp.Regexp
+func mustCompilePattern(pattern string) *regexp.Regexp {
+	reg, e := compilePattern(pattern, 0)
+	if e != nil {
+		panic(e)
+	}
+	return reg
+}
+
+// globPattern
+// @Description: Turn a glob into an anchored regular expression, * matches any run of characters
+// and ? a single character.
+// @param glob
+// @return string
+func globPattern(glob string) string {
+	quoted := regexp.QuoteMeta(glob)
+	quoted = strings.ReplaceAll(quoted, `\*`, ".*")
+	quoted = strings.ReplaceAll(quoted, `\?`, ".")
+	return `^` + quoted + `\z`
+}
+
+// IsAny
+// @Description: Determine if a string matches any of the glob patterns, * matches any run of characters
+// and ? a single character.
+// @param patterns
+// @param value
+// @param flags PatternIgnoreCase to ignore case
+// @return bool
+func IsAny(patterns []string, value string, flags ...PatternFlag) bool {
+	flag := combineFlags(flags) | PatternDotAll
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		if pattern == value {
+			return true
+		}
+		if mustCompileGlob(pattern, flag).MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// mustCompileGlob
+// @Description: Compile a glob through the shared cache, a quoted glob is always valid.
+// @param glob
+// @param flags
+// @return *regexp.Regexp
+func mustCompileGlob(glob string, flags PatternFlag) *regexp.Regexp {
+	reg, e := compilePattern(globPattern(glob), flags)
+	if e != nil {
+		panic(e)
+	}
+	return reg
+}
+
+// RegularReplaceArrayE
+// @Description: Replace a given pattern with each value in the array in sequentially.
+// @param pattern
+// @param replacements
+// @param subject
+// @return string
+// @return error an InvalidParamError for a bad pattern
+func RegularReplaceArrayE(pattern string, replacements []string, subject string) (string, error) {
+	reg, e := compilePattern(pattern, 0)
+	if e != nil {
+		return subject, e
+	}
+	index := 0
+	return reg.ReplaceAllStringFunc(subject, func(s string) string {
+		if index < len(replacements) {
+			response := replacements[index]
+			index++
+			return response
+		}
+		return s
+	}), nil
+}
+
+// Match
+// @Description: Get the first capture group of the first match, or the whole match when the pattern has
+// no group. A bad pattern panics with an InvalidParamError.
+// @param pattern
+// @param subject
+// @return string
+func Match(pattern string, subject string) string {
+	reg := mustCompilePattern(pattern)
+	groups := reg.FindStringSubmatch(subject)
+	if groups == nil {
+		return ""
+	}
+	if len(groups) > 1 {
+		return groups[1]
+	}
+	return groups[0]
+}
+
+// MatchAll
+// @Description: Get the first capture group of every match, or the whole matches when the pattern has
+// no group. A bad pattern panics with an InvalidParamError.
+// @param pattern
+// @param subject
+// @return response
+func MatchAll(pattern string, subject string) (response []string) {
+	reg := mustCompilePattern(pattern)
+	for _, groups := range reg.FindAllStringSubmatch(subject, -1) {
+		if len(groups) > 1 {
+			response = append(response, groups[1])
+		} else {
+			response = append(response, groups[0])
+		}
+	}
+	return response
+}
+
+// MatchNamed
+// @Description: Get the named capture groups of the first match, nil when nothing matches. A bad
+// pattern panics with an InvalidParamError.
+// @param pattern a pattern with (?P<name>...) groups
+// @param subject
+// @return response
+func MatchNamed(pattern string, subject string) (response map[string]string) {
+	reg := mustCompilePattern(pattern)
+	groups := reg.FindStringSubmatch(subject)
+	if groups == nil {
+		return nil
+	}
+	response = map[string]string{}
+	for i, name := range reg.SubexpNames() {
+		if name != "" {
+			response[name] = groups[i]
+		}
+	}
+	return response
+}
+
+// ReplaceMatches
+// @Description: Replace the matches of a pattern with the result of the callback, which gets the whole
+// match followed by the capture groups. A bad pattern panics with an InvalidParamError.
+// @param pattern
+// @param callback
+// @param subject
+// @param limit the most matches replaced, -1 replaces all
+// @return string
+func ReplaceMatches(pattern string, callback func(groups []string) string, subject string, limit int) string {
+	reg := mustCompilePattern(pattern)
+	var builder strings.Builder
+	last := 0
+	for _, indexes := range reg.FindAllStringSubmatchIndex(subject, limit) {
+		groups := make([]string, len(indexes)/2)
+		for i := range groups {
+			if indexes[2*i] >= 0 {
+				groups[i] = subject[indexes[2*i]:indexes[2*i+1]]
+			}
+		}
+		builder.WriteString(subject[last:indexes[0]])
+		builder.WriteString(callback(groups))
+		last = indexes[1]
+	}
+	builder.WriteString(subject[last:])
+	return builder.String()
+}
+
+// IsMatch
+// @Description: Determine if a string matches any of the regular expressions, a bad pattern panics with
+// an InvalidParamError.
+// @param patterns
+// @param value
+// @return bool
+func IsMatch(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if mustCompilePattern(pattern).MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,214 @@
+package str
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func BenchmarkIsAny(t *testing.B) {
+	type args struct {
+		patterns []string
+		value    string
+		flags    []PatternFlag
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{name: "star", args: args{patterns: []string{"foo", "api/*"}, value: "api/users"}, want: true},
+		{name: "question mark", args: args{patterns: []string{"v?.log"}, value: "v2.log"}, want: true},
+		{name: "question mark is one character", args: args{patterns: []string{"v?.log"}, value: "v10.log"}, want: false},
+		{name: "multibyte", args: args{patterns: []string{"中?"}, value: "中文"}, want: true},
+		{name: "quoted", args: args{patterns: []string{"a.c"}, value: "abc"}, want: false},
+		{name: "line breaks", args: args{patterns: []string{"a*c"}, value: "a\nb\nc"}, want: true},
+		{name: "case", args: args{patterns: []string{"API/*"}, value: "api/users"}, want: false},
+		{name: "ignore case", args: args{patterns: []string{"API/*"}, value: "api/users", flags: []PatternFlag{PatternIgnoreCase}}, want: true},
+		{name: "empty", args: args{patterns: []string{""}, value: ""}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := IsAny(tt.args.patterns, tt.args.value, tt.args.flags...); got != tt.want {
+				t.Errorf("IsAny() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkRegularReplaceArrayE(t *testing.B) {
+	t.Run("replace", func(t *testing.B) {
+		got, e := RegularReplaceArrayE(`\?`, []string{"8:30", "9:00"}, "The event will take place between ? and ?")
+		if want := "The event will take place between 8:30 and 9:00"; e != nil || got != want {
+			t.Errorf("RegularReplaceArrayE() = %v, %v, want %v", got, e, want)
+		}
+	})
+	t.Run("bad pattern", func(t *testing.B) {
+		got, e := RegularReplaceArrayE(`(`, []string{"x"}, "subject")
+		var invalid *exceptions.InvalidParamError
+		if !errors.As(e, &invalid) || got != "subject" {
+			t.Errorf("RegularReplaceArrayE() = %v, %v, want InvalidParamError", got, e)
+		}
+		if got := RegularReplaceArray(`(`, []string{"x"}, "subject"); got != "subject" {
+			t.Errorf("RegularReplaceArray() = %v, want %v", got, "subject")
+		}
+	})
+}
+
+func BenchmarkRegexCache(t *testing.B) {
+	t.Run("least recently used", func(t *testing.B) {
+		cache := &regexCache{capacity: 2, order: list.New(), entries: map[string]*list.Element{}}
+		first, _ := cache.compile("a")
+		cache.compile("b")
+		cache.compile("a")
+		cache.compile("c")
+		if _, ok := cache.entries["b"]; ok || len(cache.entries) != 2 {
+			t.Errorf("cache kept %v entries including b, want a and c", len(cache.entries))
+		}
+		if again, _ := cache.compile("a"); again != first {
+			t.Errorf("compile() did not reuse the cached pattern")
+		}
+	})
+	t.Run("bad patterns are not cached", func(t *testing.B) {
+		cache := &regexCache{capacity: 2, order: list.New(), entries: map[string]*list.Element{}}
+		if _, e := cache.compile("("); e == nil || len(cache.entries) != 0 {
+			t.Errorf("compile() = %v with %v entries, want an error and none", e, len(cache.entries))
+		}
+	})
+	t.Run("concurrent", func(t *testing.B) {
+		defer SetRegexCacheSize(512)
+		SetRegexCacheSize(8)
+		group := sync.WaitGroup{}
+		for i := 0; i < 16; i++ {
+			group.Add(1)
+			go func(i int) {
+				defer group.Done()
+				for j := 0; j < 100; j++ {
+					if !Is(fmt.Sprintf("%d-*", (i+j)%20), fmt.Sprintf("%d-x", (i+j)%20)) {
+						t.Errorf("Is() = false, want true")
+					}
+				}
+			}(i)
+		}
+		group.Wait()
+		if len(defaultRegexCache.entries) > 8 {
+			t.Errorf("cache kept %v entries, want at most 8", len(defaultRegexCache.entries))
+		}
+	})
+}
+
+func BenchmarkMatch(t *testing.B) {
+	tests := []struct {
+		name    string
+		pattern string
+		subject string
+		want    string
+		wantAll []string
+	}{
+		{name: "group", pattern: `bar(\d)`, subject: "foo bar1 bar2", want: "1", wantAll: []string{"1", "2"}},
+		{name: "whole", pattern: `ba.`, subject: "foo bar baz", want: "bar", wantAll: []string{"bar", "baz"}},
+		{name: "unicode", pattern: `(\p{Han}+)`, subject: "go 中文 and 字符", want: "中文", wantAll: []string{"中文", "字符"}},
+		{name: "none", pattern: `nope`, subject: "foo", want: "", wantAll: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Match(tt.pattern, tt.subject); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+			if got := MatchAll(tt.pattern, tt.subject); !reflect.DeepEqual(got, tt.wantAll) {
+				t.Errorf("MatchAll() = %v, want %v", got, tt.wantAll)
+			}
+		})
+	}
+}
+
+func BenchmarkMatchNamed(t *testing.B) {
+	tests := []struct {
+		name    string
+		pattern string
+		subject string
+		want    map[string]string
+	}{
+		{
+			name:    "date",
+			pattern: `(?P<year>\d{4})-(?P<month>\d{2})(-(?P<day>\d{2}))?`,
+			subject: "released 2024-05",
+			want:    map[string]string{"year": "2024", "month": "05", "day": ""},
+		},
+		{name: "none", pattern: `(?P<a>x)`, subject: "y", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := MatchNamed(tt.pattern, tt.subject); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchNamed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkReplaceMatches(t *testing.B) {
+	swap := func(groups []string) string {
+		return groups[2] + "=" + groups[1]
+	}
+	tests := []struct {
+		name    string
+		pattern string
+		subject string
+		limit   int
+		want    string
+	}{
+		{name: "all", pattern: `(\w+)=(\w+)`, subject: "a=1, b=2", limit: -1, want: "1=a, 2=b"},
+		{name: "limit", pattern: `(\w+)=(\w+)`, subject: "a=1, b=2", limit: 1, want: "1=a, b=2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := ReplaceMatches(tt.pattern, swap, tt.subject, tt.limit); got != tt.want {
+				t.Errorf("ReplaceMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkIsMatch(t *testing.B) {
+	tests := []struct {
+		name     string
+		patterns []string
+		value    string
+		want     bool
+	}{
+		{name: "any", patterns: []string{`^\d+$`, `^foo`}, value: "foobar", want: true},
+		{name: "none", patterns: []string{`^\d+$`}, value: "foobar", want: false},
+		{name: "empty", patterns: nil, value: "foobar", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := IsMatch(tt.patterns, tt.value); got != tt.want {
+				t.Errorf("IsMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkMatchBadPattern(t *testing.B) {
+	tests := map[string]func(){
+		"Match":          func() { Match(`(`, "(") },
+		"MatchAll":       func() { MatchAll(`(`, "(") },
+		"MatchNamed":     func() { MatchNamed(`(?P<a>`, "a") },
+		"ReplaceMatches": func() { ReplaceMatches(`(`, func([]string) string { return "" }, "(", -1) },
+		"IsMatch":        func() { IsMatch([]string{`bar$`, `(`}, "foo") },
+	}
+	for name, call := range tests {
+		t.Run(name, func(t *testing.B) {
+			defer func() {
+				if _, ok := recover().(*exceptions.InvalidParamError); !ok {
+					t.Errorf("%s() want InvalidParamError panic", name)
+				}
+			}()
+			call()
+		})
+	}
+}
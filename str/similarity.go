@@ -0,0 +1,285 @@
+package str
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// minInt
+// @Description: Get the smallest of the given integers.
+// @param first
+// @param others
+// @return int
+func minInt(first int, others ...int) int {
+	for _, item := range others {
+		if item < first {
+			first = item
+		}
+	}
+	return first
+}
+
+// Levenshtein
+// @Description: Get the number of single character insertions, deletions and substitutions needed to turn
+// one string into the other, characters are runes.
+// @param first
+// @param second
+// @return int
+func Levenshtein(first string, second string) int {
+	a, b := []rune(first), []rune(second)
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	prev := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(prev[j]+1, current[j-1]+1, prev[j-1]+cost)
+		}
+		prev, current = current, prev
+	}
+	return prev[len(b)]
+}
+
+// DamerauLevenshtein
+// @Description: Get the edit distance when swapping two adjacent characters also counts as a single edit,
+// so "teh" is one edit away from "the". This is the unrestricted distance, edited substrings may be edited again.
+// @param first
+// @param second
+// @return int
+func DamerauLevenshtein(first string, second string) int {
+	a, b := []rune(first), []rune(second)
+	infinity := len(a) + len(b)
+	// distance is offset by one row and column holding infinity, as in the Lowrance-Wagner algorithm
+	distance := make([][]int, len(a)+2)
+	for i := range distance {
+		distance[i] = make([]int, len(b)+2)
+	}
+	distance[0][0] = infinity
+	for i := 0; i <= len(a); i++ {
+		distance[i+1][0] = infinity
+		distance[i+1][1] = i
+	}
+	for j := 0; j <= len(b); j++ {
+		distance[0][j+1] = infinity
+		distance[1][j+1] = j
+	}
+	lastRow := map[rune]int{}
+	for i := 1; i <= len(a); i++ {
+		lastColumn := 0
+		for j := 1; j <= len(b); j++ {
+			k, l := lastRow[b[j-1]], lastColumn
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+				lastColumn = j
+			}
+			distance[i+1][j+1] = minInt(
+				distance[i][j]+cost,
+				distance[i+1][j]+1,
+				distance[i][j+1]+1,
+				distance[k][l]+(i-k-1)+1+(j-l-1),
+			)
+		}
+		lastRow[a[i-1]] = i
+	}
+	return distance[len(a)+1][len(b)+1]
+}
+
+// jaro
+// @Description: Get the Jaro similarity of two rune slices, between 0 and 1.
+// @param a
+// @param b
+// @return float64
+func jaro(a []rune, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	window := len(a)
+	if len(b) > window {
+		window = len(b)
+	}
+	window = window/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	matchedA, matchedB := make([]bool, len(a)), make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		for j := i - window; j <= i+window; j++ {
+			if j >= 0 && j < len(b) && !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+	transpositions := 0
+	j := 0
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// JaroWinkler
+// @Description: Get the Jaro-Winkler similarity between 0 and 1, strings sharing a prefix of up to four
+// characters score higher, which suits short strings such as names and flags.
+// @param first
+// @param second
+// @return float64
+func JaroWinkler(first string, second string) float64 {
+	a, b := []rune(first), []rune(second)
+	similarity := jaro(a, b)
+	prefix := 0
+	for prefix < 4 && prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return similarity + float64(prefix)*0.1*(1-similarity)
+}
+
+// similarRunes
+// @Description: Count the characters two rune slices have in common, the longest common substring plus
+// the common characters left and right of it, recursively.
+// @param a
+// @param b
+// @return int
+func similarRunes(a []rune, b []rune) int {
+	longest, startA, startB := 0, 0, 0
+	for i := range a {
+		for j := range b {
+			k := 0
+			for i+k < len(a) && j+k < len(b) && a[i+k] == b[j+k] {
+				k++
+			}
+			if k > longest {
+				longest, startA, startB = k, i, j
+			}
+		}
+	}
+	if longest == 0 {
+		return 0
+	}
+	return longest + similarRunes(a[:startA], b[:startB]) + similarRunes(a[startA+longest:], b[startB+longest:])
+}
+
+// SimilarText
+// @Description: Calculate the similarity between two strings as PHP's similar_text does, counting runes.
+// @param first
+// @param second
+// @return common the number of matching characters
+// @return percent the similarity between 0 and 100
+func SimilarText(first string, second string) (common int, percent float64) {
+	a, b := []rune(first), []rune(second)
+	if len(a)+len(b) == 0 {
+		return 0, 0
+	}
+	common = similarRunes(a, b)
+	return common, float64(common*2) * 100 / float64(len(a)+len(b))
+}
+
+// trigrams
+// @Description: Get the set of trigrams of a string, every lower case word is padded with two spaces
+// in front and one behind, as PostgreSQL's pg_trgm does.
+// @param value
+// @return map[string]bool
+func trigrams(value string) map[string]bool {
+	response := map[string]bool{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			response[string(runes[i:i+3])] = true
+		}
+	}
+	return response
+}
+
+// TrigramSimilarity
+// @Description: Get the share of trigrams two strings have in common between 0 and 1, the measure ignores
+// case and word order, which suits searching longer text.
+// @param first
+// @param second
+// @return float64
+func TrigramSimilarity(first string, second string) float64 {
+	a, b := trigrams(first), trigrams(second)
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for gram := range a {
+		if b[gram] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// ClosestMatch
+// @Description: Get the candidates similar to the input, best first, for "did you mean" suggestions.
+// Case is ignored. A candidate scores one minus its Damerau-Levenshtein distance divided by the longer
+// length, ties are ranked by Jaro-Winkler similarity and then by their order in candidates.
+// @param input
+// @param candidates
+// @param threshold the lowest score between 0 and 1 a candidate needs to be suggested
+// @return response
+func ClosestMatch(input string, candidates []string, threshold float64) (response []string) {
+	type match struct {
+		candidate string
+		score     float64
+		tie       float64
+	}
+	input = strings.ToLower(input)
+	inputLength := len([]rune(input))
+	var matches []match
+	for _, candidate := range candidates {
+		lower := strings.ToLower(candidate)
+		longest := len([]rune(lower))
+		if inputLength > longest {
+			longest = inputLength
+		}
+		score := 1.0
+		if longest > 0 {
+			score = 1 - float64(DamerauLevenshtein(input, lower))/float64(longest)
+		}
+		if score >= threshold {
+			matches = append(matches, match{candidate: candidate, score: score, tie: JaroWinkler(input, lower)})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].tie > matches[j].tie
+	})
+	for _, item := range matches {
+		response = append(response, item.candidate)
+	}
+	return response
+}
@@ -0,0 +1,193 @@
+package str
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// similaritySentences a typical pair for the benchmarks, a sentence and a mistyped copy of it.
+var similaritySentences = [2]string{
+	"The quick brown fox jumps over the lazy dog",
+	"The quikc brown fax jumped over a lazy dog",
+}
+
+func BenchmarkLevenshtein(t *testing.B) {
+	tests := []struct {
+		name   string
+		first  string
+		second string
+		want   int
+	}{
+		{name: "kitten", first: "kitten", second: "sitting", want: 3},
+		{name: "transposition", first: "teh", second: "the", want: 2},
+		{name: "unrestricted", first: "ca", second: "abc", want: 3},
+		{name: "empty", first: "", second: "abc", want: 3},
+		{name: "equal", first: "golang", second: "golang", want: 0},
+		{name: "unicode", first: "café", second: "cafe", want: 1},
+		{name: "chinese", first: "中文字符", second: "中字文符", want: 2},
+		{name: "sentence", first: similaritySentences[0], second: similaritySentences[1], want: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Levenshtein(tt.first, tt.second); got != tt.want {
+				t.Errorf("Levenshtein() = %v, want %v", got, tt.want)
+			}
+			if got := Levenshtein(tt.second, tt.first); got != tt.want {
+				t.Errorf("Levenshtein() reversed = %v, want %v", got, tt.want)
+			}
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				Levenshtein(tt.first, tt.second)
+			}
+		})
+	}
+}
+
+func BenchmarkDamerauLevenshtein(t *testing.B) {
+	tests := []struct {
+		name   string
+		first  string
+		second string
+		want   int
+	}{
+		{name: "kitten", first: "kitten", second: "sitting", want: 3},
+		{name: "transposition", first: "teh", second: "the", want: 1},
+		{name: "unrestricted", first: "ca", second: "abc", want: 2},
+		{name: "empty", first: "", second: "abc", want: 3},
+		{name: "equal", first: "golang", second: "golang", want: 0},
+		{name: "unicode", first: "café", second: "cafe", want: 1},
+		{name: "chinese", first: "中文字符", second: "中字文符", want: 1},
+		{name: "sentence", first: similaritySentences[0], second: similaritySentences[1], want: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := DamerauLevenshtein(tt.first, tt.second); got != tt.want {
+				t.Errorf("DamerauLevenshtein() = %v, want %v", got, tt.want)
+			}
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				DamerauLevenshtein(tt.first, tt.second)
+			}
+		})
+	}
+}
+
+func BenchmarkJaroWinkler(t *testing.B) {
+	tests := []struct {
+		name   string
+		first  string
+		second string
+		want   float64
+	}{
+		{name: "martha", first: "MARTHA", second: "MARHTA", want: 0.9611},
+		{name: "dixon", first: "DIXON", second: "DICKSONX", want: 0.8133},
+		{name: "disjoint", first: "abc", second: "xyz", want: 0},
+		{name: "equal", first: "日本語", second: "日本語", want: 1},
+		{name: "empty", first: "", second: "", want: 1},
+		{name: "names", first: "Jonathan Smith", second: "Jonathon Smyth", want: 0.9429},
+		{name: "sentence", first: similaritySentences[0], second: similaritySentences[1], want: 0.9338},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := JaroWinkler(tt.first, tt.second); math.Abs(got-tt.want) > 0.0001 {
+				t.Errorf("JaroWinkler() = %v, want %v", got, tt.want)
+			}
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				JaroWinkler(tt.first, tt.second)
+			}
+		})
+	}
+}
+
+func BenchmarkSimilarText(t *testing.B) {
+	tests := []struct {
+		name        string
+		first       string
+		second      string
+		wantCommon  int
+		wantPercent float64
+	}{
+		{name: "world", first: "World", second: "Word", wantCommon: 4, wantPercent: 88.8889},
+		{name: "hello", first: "Hello World", second: "Hallo Welt", wantCommon: 7, wantPercent: 66.6667},
+		{name: "unicode", first: "中文字", second: "中文", wantCommon: 2, wantPercent: 80},
+		{name: "empty", first: "", second: "", wantCommon: 0, wantPercent: 0},
+		{name: "sentence", first: similaritySentences[0], second: similaritySentences[1], wantCommon: 37, wantPercent: 87.0588},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			common, percent := SimilarText(tt.first, tt.second)
+			if common != tt.wantCommon || math.Abs(percent-tt.wantPercent) > 0.0001 {
+				t.Errorf("SimilarText() = %v, %v, want %v, %v", common, percent, tt.wantCommon, tt.wantPercent)
+			}
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				SimilarText(tt.first, tt.second)
+			}
+		})
+	}
+}
+
+func BenchmarkTrigramSimilarity(t *testing.B) {
+	tests := []struct {
+		name   string
+		first  string
+		second string
+		want   float64
+	}{
+		{name: "word", first: "word", second: "words", want: 0.5714},
+		{name: "order and case", first: "Hello World", second: "world hello", want: 1},
+		{name: "disjoint", first: "abc", second: "xyz", want: 0},
+		{name: "empty", first: "", second: "", want: 0},
+		{name: "sentence", first: similaritySentences[0], second: similaritySentences[1], want: 0.6275},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := TrigramSimilarity(tt.first, tt.second); math.Abs(got-tt.want) > 0.0001 {
+				t.Errorf("TrigramSimilarity() = %v, want %v", got, tt.want)
+			}
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				TrigramSimilarity(tt.first, tt.second)
+			}
+		})
+	}
+}
+
+func BenchmarkClosestMatch(t *testing.B) {
+	flags := []string{"version", "verbose", "help", "config", "Verify"}
+	tests := []struct {
+		name      string
+		input     string
+		threshold float64
+		want      []string
+	}{
+		{name: "typo", input: "verison", threshold: 0.7, want: []string{"version"}},
+		{name: "ranked", input: "verb", threshold: 0.3, want: []string{"verbose", "Verify", "version"}},
+		{name: "case", input: "VERIFY", threshold: 1, want: []string{"Verify"}},
+		{name: "none", input: "zzz", threshold: 0.5, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := ClosestMatch(tt.input, flags, tt.threshold); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClosestMatch() = %v, want %v", got, tt.want)
+			}
+			t.ResetTimer()
+			for i := 0; i < t.N; i++ {
+				ClosestMatch(tt.input, flags, tt.threshold)
+			}
+		})
+	}
+}
+
+func BenchmarkClosestMatchCandidates(t *testing.B) {
+	candidates := make([]string, 200)
+	for i := range candidates {
+		candidates[i] = Random(12)
+	}
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		ClosestMatch("configuration", candidates, 0.5)
+	}
+}
@@ -1,10 +1,10 @@
 // Package str
-// @Description:
+// @Description: string helpers. Offsets and lengths count user-perceived characters
+// (grapheme clusters) unless the function name says Bytes, so results stay valid UTF-8.
 package str
 
 import (
 	"html"
-	"math/rand"
 	"regexp"
 	"strconv"
 	"strings"
@@ -20,22 +20,15 @@ func E(value string) string {
 }
 
 // RegularReplaceArray
-// @Description: Replace a given pattern with each value in the array in sequentially.
+// @Description: Replace a given pattern with each value in the array in sequentially, a bad pattern
+// leaves the subject unchanged, see RegularReplaceArrayE.
 // @param pattern
 // @param replacements
 // @param subject
 // @return string
 func RegularReplaceArray(pattern string, replacements []string, subject string) string {
-	reg, _ := regexp.Compile(pattern)
-	index := 0
-	return reg.ReplaceAllStringFunc(subject, func(s string) string {
-		if index < len(replacements) {
-			response := replacements[index]
-			index++
-			return response
-		}
-		return s
-	})
+	response, _ := RegularReplaceArrayE(pattern, replacements, subject)
+	return response
 }
 
 // After
@@ -144,11 +137,11 @@ func Replace(search string, replace string, subject string) string {
 // @param subject
 // @return response
 func ReplaceOfArraySearch(search []string, replace string, subject string) (response string) {
-	response = subject
+	replacements := make(map[string]string, len(search))
 	for _, s := range search {
-		response = strings.Replace(response, s, replace, -1)
+		replacements[s] = replace
 	}
-	return response
+	return Strtr(subject, replacements)
 }
 
 // ReplaceFirst
@@ -198,14 +191,8 @@ func ReplaceLast(search string, replace string, subject string) string {
 // @param length
 // @return string
 func Substr(subject string, start int, length int) string {
-	if start >= len(subject) {
-		return ""
-	}
-	end := start + length
-	if end >= len(subject) {
-		end = len(subject)
-	}
-	return subject[start:end]
+	offsets := graphemeOffsets(subject)
+	return graphemeSlice(subject, offsets, start, start+length)
 }
 
 // SubstrCount
@@ -228,7 +215,8 @@ func SubstrCount(haystack string, needle string) int {
 // @param length
 // @return string
 func SubstrReplace(subject string, replace string, offset int, length int) string {
-	l := len(subject)
+	offsets := graphemeOffsets(subject)
+	l := len(offsets) - 1
 	if offset < 0 {
 		offset += l
 	}
@@ -237,9 +225,7 @@ func SubstrReplace(subject string, replace string, offset int, length int) strin
 	} else if offset < 0 {
 		offset = 0
 	}
-	start := subject[:offset]
-	end := subject[offset:]
-	l = len(end)
+	l -= offset
 	if length < 0 {
 		length += l
 	}
@@ -248,7 +234,7 @@ func SubstrReplace(subject string, replace string, offset int, length int) strin
 	} else if length < 0 {
 		length = 0
 	}
-	return start + replace + end[length:]
+	return subject[:offsets[offset]] + replace + subject[offsets[offset+length]:]
 }
 
 // Contains
@@ -259,8 +245,7 @@ func SubstrReplace(subject string, replace string, offset int, length int) strin
 // @return bool
 func Contains(haystack string, needles string, ignoreCase bool) bool {
 	if ignoreCase {
-		haystack = strings.ToLower(haystack)
-		needles = strings.ToLower(needles)
+		return ContainsFold(haystack, needles)
 	}
 	if needles == "" {
 		return false
@@ -279,12 +264,20 @@ func Contains(haystack string, needles string, ignoreCase bool) bool {
 // @param ignoreCase
 // @return bool
 func ContainsAny(haystack string, needles []string, ignoreCase bool) bool {
-	for _, needle := range needles {
-		if Contains(haystack, needle, ignoreCase) {
-			return true
+	if len(needles) < acMinNeedles {
+		for _, needle := range needles {
+			if Contains(haystack, needle, ignoreCase) {
+				return true
+			}
 		}
+		return false
 	}
-	return false
+	found := false
+	newACMatcher(needles, ignoreCase).scan(haystack, func(match acMatch) bool {
+		found = true
+		return false
+	})
+	return found
 }
 
 // ContainsAll
@@ -295,11 +288,38 @@ func ContainsAny(haystack string, needles []string, ignoreCase bool) bool {
 // @return bool
 func ContainsAll(haystack string, needles []string, ignoreCase bool) bool {
 	for _, needle := range needles {
-		if !Contains(haystack, needle, ignoreCase) {
+		if needle == "" {
 			return false
 		}
 	}
-	return true
+	if len(needles) < acMinNeedles {
+		for _, needle := range needles {
+			if !Contains(haystack, needle, ignoreCase) {
+				return false
+			}
+		}
+		return true
+	}
+	matcher := newACMatcher(needles, ignoreCase)
+	// every node with an output is a distinct needle, needles equal under folding share one
+	remaining := 0
+	for _, node := range matcher.nodes {
+		if node.output >= 0 {
+			remaining++
+		}
+	}
+	if remaining == 0 {
+		return true
+	}
+	seen := map[int]bool{}
+	matcher.scan(haystack, func(match acMatch) bool {
+		if !seen[match.needle] {
+			seen[match.needle] = true
+			remaining--
+		}
+		return remaining > 0
+	})
+	return remaining == 0
 }
 
 // EndsWith
@@ -308,26 +328,23 @@ func ContainsAll(haystack string, needles []string, ignoreCase bool) bool {
 // @param needles
 // @return bool
 func EndsWith(haystack string, needles string) bool {
-	if haystack == "" || needles == "" {
+	if needles == "" {
 		return false
 	}
-	index := strings.Index(haystack, needles)
-	if index > 0 && index+len(needles) == len(haystack) {
-		return true
-	}
-	return false
+	return strings.HasSuffix(haystack, needles)
 }
 
 // Length
-// @Description:
+// @Description: Return the number of user-perceived characters in the given string.
 // @param value
 // @return int
 func Length(value string) int {
-	return len(value)
+	return len(graphemeOffsets(value)) - 1
 }
 
 // Is
-// @Description:Determine if a given string matches a given pattern.
+// @Description:Determine if a given string matches a given pattern, * matches any run of characters and
+// everything else is literal, see IsAny for ? and flags.
 // @param pattern
 // @param value
 // @return bool
@@ -338,10 +355,8 @@ func Is(pattern string, value string) bool {
 	if pattern == value {
 		return true
 	}
-	pattern = regexp.QuoteMeta(pattern)
-	pattern = strings.ReplaceAll(pattern, "\\*", ".*")
-	match, _ := regexp.MatchString("^"+pattern+"$", value)
-	return match
+	pattern = strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*")
+	return mustCompilePattern("^" + pattern + "$").MatchString(value)
 }
 
 // Finish
@@ -351,7 +366,7 @@ func Is(pattern string, value string) bool {
 // @return string
 func Finish(value string, cap string) string {
 	quoted := regexp.QuoteMeta(cap)
-	reg, _ := regexp.Compile("(?:" + quoted + ")+$")
+	reg := mustCompilePattern(`(?:` + quoted + `)+\z`)
 	response := reg.ReplaceAllString(value, "") + cap
 	return response
 }
@@ -363,10 +378,29 @@ func Finish(value string, cap string) string {
 // @param end
 // @return string
 func Limit(value string, limit int, end string) string {
+	offsets := graphemeOffsets(value)
+	if len(offsets)-1 <= limit {
+		return value
+	}
+	return graphemeSlice(value, offsets, 0, limit) + end
+}
+
+// LimitBytes
+// @Description: Limit the number of bytes in a string without cutting a character in half.
+// @param value
+// @param limit
+// @param end
+// @return string
+func LimitBytes(value string, limit int, end string) string {
 	if len(value) <= limit {
 		return value
 	}
-	return value[:limit] + end
+	offsets := graphemeOffsets(value)
+	index := 0
+	for index+1 < len(offsets) && offsets[index+1] <= limit {
+		index++
+	}
+	return value[:offsets[index]] + end
 }
 
 // Lower
@@ -392,11 +426,13 @@ func Upper(value string) string {
 // @param pad
 // @return response
 func PadBoth(value string, length int, pad string) (response string) {
-	if len(value) >= length {
+	padLength := Length(pad)
+	if Length(value) >= length || padLength == 0 {
 		return value
 	}
 	flag := true
-	for response = value; len(response) < length; {
+	responseLength := Length(value)
+	for response = value; responseLength < length; responseLength += padLength {
 		if flag == true {
 			response += pad
 		} else {
@@ -404,10 +440,11 @@ func PadBoth(value string, length int, pad string) (response string) {
 		}
 		flag = !flag
 	}
+	offsets := graphemeOffsets(response)
 	if flag {
-		response = response[len(response)-length:]
+		response = graphemeSlice(response, offsets, responseLength-length, responseLength)
 	} else {
-		response = response[:length]
+		response = graphemeSlice(response, offsets, 0, length)
 	}
 	return response
 }
@@ -419,11 +456,7 @@ func PadBoth(value string, length int, pad string) (response string) {
 // @param pad
 // @return string
 func PadLeft(value string, length int, pad string) string {
-	strLen := len(value)
-	if strLen >= length {
-		return value
-	}
-	return strings.Repeat(pad, int((length-strLen+1)/len(pad)))[:length-strLen] + value
+	return repeatGraphemes(pad, length-Length(value)) + value
 }
 
 // PadRight
@@ -433,11 +466,7 @@ func PadLeft(value string, length int, pad string) string {
 // @param pad
 // @return string
 func PadRight(value string, length int, pad string) string {
-	strLen := len(value)
-	if strLen >= length {
-		return value
-	}
-	return value + strings.Repeat(pad, int((length-strLen+1)/len(pad)))[:length-strLen]
+	return value + repeatGraphemes(pad, length-Length(value))
 }
 
 // Start
@@ -447,7 +476,7 @@ func PadRight(value string, length int, pad string) string {
 // @return string
 func Start(value string, prefix string) string {
 	quoted := regexp.QuoteMeta(prefix)
-	reg, _ := regexp.Compile("^(?:" + quoted + ")+")
+	reg := mustCompilePattern(`^(?:` + quoted + `)+`)
 	response := prefix + reg.ReplaceAllString(value, "")
 	return response
 }
@@ -458,14 +487,10 @@ func Start(value string, prefix string) string {
 // @param needles
 // @return bool
 func StartsWith(haystack string, needles string) bool {
-	if haystack == "" || needles == "" {
+	if needles == "" {
 		return false
 	}
-	index := strings.Index(haystack, needles)
-	if index == 0 {
-		return true
-	}
-	return false
+	return strings.HasPrefix(haystack, needles)
 }
 
 // IsAscii
@@ -481,21 +506,24 @@ func IsAscii(value string) bool {
 	return true
 }
 
-const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+const alphaNumericBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
 // Random
-// @Description:Generate a more truly "random" alpha-numeric string.
+// @Description:Generate a more truly "random" alpha-numeric string from a cryptographically secure source.
+// The generator can be replaced in tests with CreateRandomStringsUsing.
 // @param length
 // @return string
 func Random(length int) string {
 	if length <= 0 {
 		return ""
 	}
-	b := make([]byte, length)
-	for i := range b {
-		b[i] = letterBytes[rand.Intn(len(letterBytes))]
+	randomStringMutex.RLock()
+	factory := randomStringFactory
+	randomStringMutex.RUnlock()
+	if factory != nil {
+		return factory(length)
 	}
-	return string(b)
+	return SecureRandom(length, alphaNumericBytes)
 }
 
 // UcFirst
@@ -519,23 +547,21 @@ func LcFirst(value string) string {
 // @param value
 // @return string
 func Reverse(value string) string {
-	rns := []rune(value) // convert to rune
-	for i, j := 0, len(rns)-1; i < j; i, j = i+1, j-1 {
-		rns[i], rns[j] = rns[j], rns[i]
+	characters := Graphemes(value) // keep combining marks and emoji sequences intact
+	for i, j := 0, len(characters)-1; i < j; i, j = i+1, j-1 {
+		characters[i], characters[j] = characters[j], characters[i]
 	}
-	return string(rns)
+	return strings.Join(characters, "")
 }
 
 // Swap
-// @Description: Swap multiple keywords in a string with other keywords.
+// @Description: Swap multiple keywords in a string with other keywords in a single pass, the longest
+// keyword wins where several match, see Strtr.
 // @param swapMap
 // @param subject
 // @return string
 func Swap(swapMap map[string]string, subject string) string {
-	for search, replace := range swapMap {
-		subject = Replace(search, replace, subject)
-	}
-	return subject
+	return Strtr(subject, swapMap)
 }
 
 // Remove
@@ -548,76 +574,66 @@ func Remove(search string, subject string, caseSensitive bool) (response string)
 	if caseSensitive {
 		return Replace(search, "", subject)
 	}
-	if search == "" || subject == "" {
-		return subject
-	}
-	sub := Lower(subject)
-	search = Lower(search)
-	l := len(search)
-	lastIndex := 0
-	for {
-		i := strings.Index(sub, search)
-		if i < 0 {
-			break
-		}
-		response += subject[lastIndex : lastIndex+i]
-
-		lastIndex = i + l
-		sub = sub[lastIndex:]
-	}
-	response += sub
-	return response
+	return RemoveFold(search, subject)
 }
 
 // Studly
-// @Description: Convert a value to studly caps case.
+// @Description: Convert a value to studly caps case, registered acronyms are kept in upper case (UserID).
 // @param value
 // @return string
 func Studly(value string) (response string) {
 	if value == "" {
 		return value
 	}
-	value = ReplaceOfArraySearch([]string{"-", "_"}, " ", value)
-	for _, word := range strings.Split(value, " ") {
-		response += UcFirst(word)
-	}
-	return response
+	return studlyCache.remember(value, func() string {
+		var builder strings.Builder
+		for _, word := range splitWords(value, true) {
+			builder.WriteString(studlyWord(word))
+		}
+		return builder.String()
+	})
 }
 
 // Camel
-// @Description: Convert a value to camel case.
+// @Description: Convert a value to camel case, the first word is lower case even when it is an acronym.
 // @param value
 // @return response
 func Camel(value string) (response string) {
 	if value == "" {
 		return value
 	}
-	response = Studly(value)
-	return LcFirst(response)
+	return camelCache.remember(value, func() string {
+		var builder strings.Builder
+		for i, word := range splitWords(value, true) {
+			if i == 0 {
+				builder.WriteString(strings.ToLower(word))
+				continue
+			}
+			builder.WriteString(studlyWord(word))
+		}
+		return builder.String()
+	})
 }
 
 // Headline
-// @Description: Convert the given string to title case for each word.
+// @Description: Convert the given string to title case for each word, punctuation other than spaces,
+// dashes and underscores is kept (User.name).
 // @param value
 // @return response
 func Headline(value string) (response string) {
 	if value == "" {
 		return value
 	}
-	reg, _ := regexp.Compile("([A-Z]+)")
-	response = reg.ReplaceAllStringFunc(value, func(s string) string {
-		return "_" + s
-	})
-	response = ReplaceOfArraySearch([]string{"-", "_"}, " ", response)
-	var responseArr []string
-	for _, word := range strings.Split(response, " ") {
-		responseArr = append(responseArr, UcFirst(word))
+	words := splitWords(value, true)
+	for i, word := range words {
+		words[i] = studlyWord(word)
 	}
-	return strings.TrimLeft(strings.Join(responseArr, " "), " ")
+	return strings.Join(words, " ")
 }
 
 // SnakeOfCustom
-// @Description: Convert a string to kebab case.
+// @Description: Convert a string to lower case words joined by the delimiter. Words are split as
+// SplitWords does, but punctuation other than spaces, dashes and underscores is kept (user.name).
 // @param value
 // @param delimiter
 // @return response
@@ -625,33 +641,17 @@ func SnakeOfCustom(value string, delimiter string) (response string) {
 	if value == "" {
 		return value
 	}
-
-	strBuilder := strings.Builder{}
-	for _, w := range value {
-		// asset w is A~Z
-		if w >= 65 && w <= 90 {
-			strBuilder.WriteByte('_')
+	return snakeCache.remember(delimiter+"\x00"+value, func() string {
+		words := splitWords(value, true)
+		for i, word := range words {
+			words[i] = strings.ToLower(word)
 		}
-		strBuilder.WriteRune(w)
-	}
-	response = strBuilder.String()
-
-	// Revision does not use re
-	//reg, _ := regexp.Compile("([A-Z]+)")
-	//response = reg.ReplaceAllStringFunc(value, func(s string) string {
-	//	return "_" + s
-	//})
-
-	response = ReplaceOfArraySearch([]string{"-", "_"}, " ", response)
-	var responseArr []string
-	for _, word := range strings.Split(response, " ") {
-		responseArr = append(responseArr, LcFirst(word))
-	}
-	return strings.TrimLeft(strings.Join(responseArr, delimiter), " ")
+		return strings.Join(words, delimiter)
+	})
 }
 
 // Snake
-// @Description:Convert a string to kebab case.
+// @Description: Convert a string to snake case.
 // @param value
 // @return response
 func Snake(value string) (response string) {
@@ -674,7 +674,8 @@ func Kebab(value string) (response string) {
 // @param length
 // @return string
 func Mask(subject string, character string, index int, length int) string {
-	subLen := len(subject)
+	offsets := graphemeOffsets(subject)
+	subLen := len(offsets) - 1
 	if index < 0 {
 		index += subLen
 	}
@@ -698,11 +699,12 @@ func Mask(subject string, character string, index int, length int) string {
 	if offset > subLen {
 		offset = subLen
 	}
-	return subject[:index] + PadBoth("", offset-index, "*") + subject[offset:]
+	return subject[:offsets[index]] + repeatGraphemes(character, offset-index) + subject[offsets[offset]:]
 }
 
 // Excerpt
-// @Description: Extracts an excerpt from text that matches the first instance of a phrase.
+// @Description: Extracts an excerpt from text that matches the first instance of a phrase, the
+// excerpt never ends in the middle of a word.
 // @param text
 // @param phrase
 // @param options
@@ -722,17 +724,37 @@ func Excerpt(text string, phrase string, options map[string]string) (response st
 			omission = options["omission"]
 		}
 	}
-	if index-radius > 0 {
-		response += omission
+	offsets := graphemeOffsets(text)
+	count := len(offsets) - 1
+	// grapheme positions of the phrase, widened to whole characters
+	start, end := 0, count
+	for start+1 <= count && offsets[start+1] <= index {
+		start++
 	}
-	if index < radius {
-		response += text[:index+len(phrase)]
-	} else {
-		response += text[index-radius : index+len(phrase)]
+	for end-1 >= 0 && offsets[end-1] >= index+len(phrase) {
+		end--
 	}
-	if index+len(phrase)+radius > len(text) {
-		return response + text[index+len(phrase):]
-	} else {
-		return response + text[index+len(phrase):index+len(phrase)+radius] + omission
+	// widen by the radius, then give back characters until no word is cut in half
+	clusters := Graphemes(text)
+	from, to := start-radius, end+radius
+	if from < 0 {
+		from = 0
+	}
+	if to > count {
+		to = count
+	}
+	for from < start && !isWordBoundary(clusters, from) {
+		from++
 	}
+	for to > end && !isWordBoundary(clusters, to) {
+		to--
+	}
+	if from > 0 {
+		response += omission
+	}
+	response += graphemeSlice(text, offsets, from, to)
+	if to < count {
+		response += omission
+	}
+	return response
 }
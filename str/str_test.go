@@ -2,6 +2,7 @@ package str
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -901,6 +902,20 @@ func Benchmark_is(t *testing.B) {
 				value:   "foobar",
 			},
 			want: false,
+		}, {
+			name: "literal question mark",
+			args: args{
+				pattern: "/search?q=*",
+				value:   "/search?q=go",
+			},
+			want: true,
+		}, {
+			name: "question mark is not a wildcard",
+			args: args{
+				pattern: "a?c",
+				value:   "abc",
+			},
+			want: false,
 		},
 	}
 	for _, tt := range tests {
@@ -1311,6 +1326,12 @@ func BenchmarkRandom(t *testing.B) {
 			}
 		})
 	}
+	t.Run("alpha-numeric", func(t *testing.B) {
+		got := Random(2000)
+		if strings.Trim(got, alphaNumericBytes) != "" || !strings.ContainsAny(got, "0123456789") {
+			t.Errorf("Random() = %v, want letters and digits", got)
+		}
+	})
 }
 
 func BenchmarkUcFirst(t *testing.B) {
@@ -1460,7 +1481,7 @@ func BenchmarkRemove(t *testing.B) {
 				subject:       "thisAIsMyName",
 				caseSensitive: false,
 			},
-			want: "thAmyname",
+			want: "thAMyName",
 		}, {
 			name: "this is my name",
 			args: args{
@@ -1772,7 +1793,7 @@ func BenchmarkExcerpt(t *testing.B) {
 				phrase:  "my",
 				options: map[string]string{"radius": "4", "omission": "...."},
 			},
-			wantResponse: ".... is my nam....",
+			wantResponse: ".... is my ....",
 		}, {
 			name: "This is my name",
 			args: args{
@@ -0,0 +1,946 @@
+package str
+
+import (
+	"encoding/json"
+)
+
+// Stringable
+// @Description: an immutable string wrapper whose methods can be chained,
+// every method returns a new Stringable and leaves the receiver untouched.
+type Stringable struct {
+	value string
+}
+
+// Of
+// @Description: Get a new stringable object from the given string.
+// @param value
+// @return Stringable
+func Of(value string) Stringable {
+	return Stringable{value: value}
+}
+
+// String
+// @Description: Get the raw string value.
+// @receiver s
+// @return string
+func (s Stringable) String() string {
+	return s.value
+}
+
+// MarshalJSON
+// @Description: Encode the stringable as a JSON string.
+// @receiver s
+// @return []byte
+// @return error
+func (s Stringable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.value)
+}
+
+// UnmarshalJSON
+// @Description: Decode a JSON string into the stringable.
+// @receiver s
+// @param data
+// @return error
+func (s *Stringable) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &s.value)
+}
+
+// When
+// @Description: Apply the callback if the given condition is true.
+// @receiver s
+// @param condition
+// @param callback
+// @return Stringable
+func (s Stringable) When(condition bool, callback func(Stringable) Stringable) Stringable {
+	if condition {
+		return callback(s)
+	}
+	return s
+}
+
+// Unless
+// @Description: Apply the callback if the given condition is false.
+// @receiver s
+// @param condition
+// @param callback
+// @return Stringable
+func (s Stringable) Unless(condition bool, callback func(Stringable) Stringable) Stringable {
+	return s.When(!condition, callback)
+}
+
+// Pipe
+// @Description: Call the given function with the string and wrap the result.
+// @receiver s
+// @param callback
+// @return Stringable
+func (s Stringable) Pipe(callback func(string) string) Stringable {
+	return Of(callback(s.value))
+}
+
+// Tap
+// @Description: Call the given callback with the stringable and return it unchanged.
+// @receiver s
+// @param callback
+// @return Stringable
+func (s Stringable) Tap(callback func(Stringable)) Stringable {
+	callback(s)
+	return s
+}
+
+// Append
+// @Description: Append the given values to the string.
+// @receiver s
+// @param values
+// @return Stringable
+func (s Stringable) Append(values ...string) Stringable {
+	for _, value := range values {
+		s.value += value
+	}
+	return s
+}
+
+// Prepend
+// @Description: Prepend the given values to the string.
+// @receiver s
+// @param values
+// @return Stringable
+func (s Stringable) Prepend(values ...string) Stringable {
+	prefix := ""
+	for _, value := range values {
+		prefix += value
+	}
+	return Of(prefix + s.value)
+}
+
+// E
+// @Description: Encode HTML special characters in the string.
+// @receiver s
+// @return Stringable
+func (s Stringable) E() Stringable {
+	return Of(E(s.value))
+}
+
+// After
+// @Description: Return the remainder of the string after the first occurrence of a given value.
+// @receiver s
+// @param search
+// @return Stringable
+func (s Stringable) After(search string) Stringable {
+	return Of(After(s.value, search))
+}
+
+// AfterLast
+// @Description: Return the remainder of the string after the last occurrence of a given value.
+// @receiver s
+// @param search
+// @return Stringable
+func (s Stringable) AfterLast(search string) Stringable {
+	return Of(AfterLast(s.value, search))
+}
+
+// Before
+// @Description: Get the portion of the string before the first occurrence of a given value.
+// @receiver s
+// @param search
+// @return Stringable
+func (s Stringable) Before(search string) Stringable {
+	return Of(Before(s.value, search))
+}
+
+// BeforeLast
+// @Description: Get the portion of the string before the last occurrence of a given value.
+// @receiver s
+// @param search
+// @return Stringable
+func (s Stringable) BeforeLast(search string) Stringable {
+	return Of(BeforeLast(s.value, search))
+}
+
+// Between
+// @Description: Get the portion of the string between two given values.
+// @receiver s
+// @param from
+// @param to
+// @return Stringable
+func (s Stringable) Between(from string, to string) Stringable {
+	return Of(Between(s.value, from, to))
+}
+
+// BetweenFirst
+// @Description: Get the smallest possible portion of the string between two given values.
+// @receiver s
+// @param from
+// @param to
+// @return Stringable
+func (s Stringable) BetweenFirst(from string, to string) Stringable {
+	return Of(BetweenFirst(s.value, from, to))
+}
+
+// Replace
+// @Description: Replace the given value in the string.
+// @receiver s
+// @param search
+// @param replace
+// @return Stringable
+func (s Stringable) Replace(search string, replace string) Stringable {
+	return Of(Replace(search, replace, s.value))
+}
+
+// ReplaceOfArraySearch
+// @Description: Replace every one of the given values in the string.
+// @receiver s
+// @param search
+// @param replace
+// @return Stringable
+func (s Stringable) ReplaceOfArraySearch(search []string, replace string) Stringable {
+	return Of(ReplaceOfArraySearch(search, replace, s.value))
+}
+
+// ReplaceFirst
+// @Description: Replace the first occurrence of a given value in the string.
+// @receiver s
+// @param search
+// @param replace
+// @return Stringable
+func (s Stringable) ReplaceFirst(search string, replace string) Stringable {
+	return Of(ReplaceFirst(search, replace, s.value))
+}
+
+// ReplaceLast
+// @Description: Replace the last occurrence of a given value in the string.
+// @receiver s
+// @param search
+// @param replace
+// @return Stringable
+func (s Stringable) ReplaceLast(search string, replace string) Stringable {
+	return Of(ReplaceLast(search, replace, s.value))
+}
+
+// ReplaceArray
+// @Description: Replace a given value in the string sequentially with an array.
+// @receiver s
+// @param search
+// @param replace
+// @return Stringable
+func (s Stringable) ReplaceArray(search string, replace []string) Stringable {
+	return Of(ReplaceArray(search, replace, s.value))
+}
+
+// RegularReplaceArray
+// @Description: Replace a given pattern with each value in the array in sequentially.
+// @receiver s
+// @param pattern
+// @param replacements
+// @return Stringable
+func (s Stringable) RegularReplaceArray(pattern string, replacements []string) Stringable {
+	return Of(RegularReplaceArray(pattern, replacements, s.value))
+}
+
+// Substr
+// @Description: Returns the portion of the string specified by the start and length parameters.
+// @receiver s
+// @param start
+// @param length
+// @return Stringable
+func (s Stringable) Substr(start int, length int) Stringable {
+	return Of(Substr(s.value, start, length))
+}
+
+// SubstrReplace
+// @Description: Replace text within a portion of the string.
+// @receiver s
+// @param replace
+// @param offset
+// @param length
+// @return Stringable
+func (s Stringable) SubstrReplace(replace string, offset int, length int) Stringable {
+	return Of(SubstrReplace(s.value, replace, offset, length))
+}
+
+// Limit
+// @Description: Limit the number of characters in the string.
+// @receiver s
+// @param limit
+// @param end
+// @return Stringable
+func (s Stringable) Limit(limit int, end string) Stringable {
+	return Of(Limit(s.value, limit, end))
+}
+
+// LimitBytes
+// @Description: Limit the number of bytes in the string without cutting a character in half.
+// @receiver s
+// @param limit
+// @param end
+// @return Stringable
+func (s Stringable) LimitBytes(limit int, end string) Stringable {
+	return Of(LimitBytes(s.value, limit, end))
+}
+
+// Lower
+// @Description: Convert the string to lower-case.
+// @receiver s
+// @return Stringable
+func (s Stringable) Lower() Stringable {
+	return Of(Lower(s.value))
+}
+
+// Upper
+// @Description: Convert the string to upper-case.
+// @receiver s
+// @return Stringable
+func (s Stringable) Upper() Stringable {
+	return Of(Upper(s.value))
+}
+
+// UcFirst
+// @Description: Make the string's first character uppercase.
+// @receiver s
+// @return Stringable
+func (s Stringable) UcFirst() Stringable {
+	return Of(UcFirst(s.value))
+}
+
+// LcFirst
+// @Description: Make the string's first character lowercase.
+// @receiver s
+// @return Stringable
+func (s Stringable) LcFirst() Stringable {
+	return Of(LcFirst(s.value))
+}
+
+// PadBoth
+// @Description: Pad both sides of the string with another.
+// @receiver s
+// @param length
+// @param pad
+// @return Stringable
+func (s Stringable) PadBoth(length int, pad string) Stringable {
+	return Of(PadBoth(s.value, length, pad))
+}
+
+// PadLeft
+// @Description: Pad the left side of the string with another.
+// @receiver s
+// @param length
+// @param pad
+// @return Stringable
+func (s Stringable) PadLeft(length int, pad string) Stringable {
+	return Of(PadLeft(s.value, length, pad))
+}
+
+// PadRight
+// @Description: Pad the right side of the string with another.
+// @receiver s
+// @param length
+// @param pad
+// @return Stringable
+func (s Stringable) PadRight(length int, pad string) Stringable {
+	return Of(PadRight(s.value, length, pad))
+}
+
+// Start
+// @Description: Begin the string with a single instance of a given value.
+// @receiver s
+// @param prefix
+// @return Stringable
+func (s Stringable) Start(prefix string) Stringable {
+	return Of(Start(s.value, prefix))
+}
+
+// Finish
+// @Description: Cap the string with a single instance of a given value.
+// @receiver s
+// @param cap
+// @return Stringable
+func (s Stringable) Finish(cap string) Stringable {
+	return Of(Finish(s.value, cap))
+}
+
+// Snake
+// @Description: Convert the string to snake case.
+// @receiver s
+// @return Stringable
+func (s Stringable) Snake() Stringable {
+	return Of(Snake(s.value))
+}
+
+// SnakeOfCustom
+// @Description: Convert the string to snake case with the given delimiter.
+// @receiver s
+// @param delimiter
+// @return Stringable
+func (s Stringable) SnakeOfCustom(delimiter string) Stringable {
+	return Of(SnakeOfCustom(s.value, delimiter))
+}
+
+// Kebab
+// @Description: Convert the string to kebab case.
+// @receiver s
+// @return Stringable
+func (s Stringable) Kebab() Stringable {
+	return Of(Kebab(s.value))
+}
+
+// Camel
+// @Description: Convert the string to camel case.
+// @receiver s
+// @return Stringable
+func (s Stringable) Camel() Stringable {
+	return Of(Camel(s.value))
+}
+
+// Studly
+// @Description: Convert the string to studly caps case.
+// @receiver s
+// @return Stringable
+func (s Stringable) Studly() Stringable {
+	return Of(Studly(s.value))
+}
+
+// Headline
+// @Description: Convert the string to title case for each word.
+// @receiver s
+// @return Stringable
+func (s Stringable) Headline() Stringable {
+	return Of(Headline(s.value))
+}
+
+// Mask
+// @Description: Mask a portion of the string with a repeated character.
+// @receiver s
+// @param character
+// @param index
+// @param length
+// @return Stringable
+func (s Stringable) Mask(character string, index int, length int) Stringable {
+	return Of(Mask(s.value, character, index, length))
+}
+
+// Excerpt
+// @Description: Extract an excerpt from the string that matches the first instance of a phrase.
+// @receiver s
+// @param phrase
+// @param options
+// @return Stringable
+func (s Stringable) Excerpt(phrase string, options map[string]string) Stringable {
+	return Of(Excerpt(s.value, phrase, options))
+}
+
+// Remove
+// @Description: Remove any occurrence of the given string.
+// @receiver s
+// @param search
+// @param caseSensitive
+// @return Stringable
+func (s Stringable) Remove(search string, caseSensitive bool) Stringable {
+	return Of(Remove(search, s.value, caseSensitive))
+}
+
+// Swap
+// @Description: Swap multiple keywords in the string with other keywords.
+// @receiver s
+// @param swapMap
+// @return Stringable
+func (s Stringable) Swap(swapMap map[string]string) Stringable {
+	return Of(Swap(swapMap, s.value))
+}
+
+// Reverse
+// @Description: Reverse the string.
+// @receiver s
+// @return Stringable
+func (s Stringable) Reverse() Stringable {
+	return Of(Reverse(s.value))
+}
+
+// Is
+// @Description: Determine if the string matches a given pattern.
+// @receiver s
+// @param pattern
+// @return bool
+func (s Stringable) Is(pattern string) bool {
+	return Is(pattern, s.value)
+}
+
+// IsAny
+// @Description: Determine if the string matches any of the glob patterns.
+// @receiver s
+// @param patterns
+// @param flags
+// @return bool
+func (s Stringable) IsAny(patterns []string, flags ...PatternFlag) bool {
+	return IsAny(patterns, s.value, flags...)
+}
+
+// IsAscii
+// @Description: Determine if the string is 7 bit ASCII.
+// @receiver s
+// @return bool
+func (s Stringable) IsAscii() bool {
+	return IsAscii(s.value)
+}
+
+// IsEmpty
+// @Description: Determine if the string is empty.
+// @receiver s
+// @return bool
+func (s Stringable) IsEmpty() bool {
+	return s.value == ""
+}
+
+// IsNotEmpty
+// @Description: Determine if the string is not empty.
+// @receiver s
+// @return bool
+func (s Stringable) IsNotEmpty() bool {
+	return !s.IsEmpty()
+}
+
+// Contains
+// @Description: Determine if the string contains a given substring.
+// @receiver s
+// @param needles
+// @param ignoreCase
+// @return bool
+func (s Stringable) Contains(needles string, ignoreCase bool) bool {
+	return Contains(s.value, needles, ignoreCase)
+}
+
+// ContainsAny
+// @Description: Determine if the string contains any one of the given values.
+// @receiver s
+// @param needles
+// @param ignoreCase
+// @return bool
+func (s Stringable) ContainsAny(needles []string, ignoreCase bool) bool {
+	return ContainsAny(s.value, needles, ignoreCase)
+}
+
+// ContainsAll
+// @Description: Determine if the string contains all of the given values.
+// @receiver s
+// @param needles
+// @param ignoreCase
+// @return bool
+func (s Stringable) ContainsAll(needles []string, ignoreCase bool) bool {
+	return ContainsAll(s.value, needles, ignoreCase)
+}
+
+// StartsWith
+// @Description: Determine if the string starts with a given substring.
+// @receiver s
+// @param needles
+// @return bool
+func (s Stringable) StartsWith(needles string) bool {
+	return StartsWith(s.value, needles)
+}
+
+// EndsWith
+// @Description: Determine if the string ends with a given substring.
+// @receiver s
+// @param needles
+// @return bool
+func (s Stringable) EndsWith(needles string) bool {
+	return EndsWith(s.value, needles)
+}
+
+// Length
+// @Description: Return the number of user-perceived characters in the string.
+// @receiver s
+// @return int
+func (s Stringable) Length() int {
+	return Length(s.value)
+}
+
+// SubstrCount
+// @Description: Returns the number of substring occurrences.
+// @receiver s
+// @param needle
+// @return int
+func (s Stringable) SubstrCount(needle string) int {
+	return SubstrCount(s.value, needle)
+}
+
+// Graphemes
+// @Description: Split the string into user-perceived characters.
+// @receiver s
+// @return []string
+func (s Stringable) Graphemes() []string {
+	return Graphemes(s.value)
+}
+
+// SplitWords
+// @Description: Split the string into its words.
+// @receiver s
+// @return []string
+func (s Stringable) SplitWords() []string {
+	return SplitWords(s.value)
+}
+
+// Words
+// @Description: Limit the number of words in the string.
+// @receiver s
+// @param words
+// @param end
+// @return Stringable
+func (s Stringable) Words(words int, end string) Stringable {
+	return Of(Words(s.value, words, end))
+}
+
+// WordCount
+// @Description: Count the words in the string.
+// @receiver s
+// @return int
+func (s Stringable) WordCount() int {
+	return WordCount(s.value)
+}
+
+// WordWrap
+// @Description: Wrap the string to lines of at most width terminal columns.
+// @receiver s
+// @param width
+// @param breakString
+// @param cutLongWords
+// @return Stringable
+func (s Stringable) WordWrap(width int, breakString string, cutLongWords bool) Stringable {
+	return Of(WordWrap(s.value, width, breakString, cutLongWords))
+}
+
+// Squish
+// @Description: Trim the string and collapse its inner white space.
+// @receiver s
+// @return Stringable
+func (s Stringable) Squish() Stringable {
+	return Of(Squish(s.value))
+}
+
+// Width
+// @Description: Get the number of terminal columns the string takes.
+// @receiver s
+// @return int
+func (s Stringable) Width() int {
+	return Width(s.value)
+}
+
+// PadLeftWidth
+// @Description: Pad the left side of the string until it takes width terminal columns.
+// @receiver s
+// @param width
+// @param pad
+// @return Stringable
+func (s Stringable) PadLeftWidth(width int, pad string) Stringable {
+	return Of(PadLeftWidth(s.value, width, pad))
+}
+
+// PadRightWidth
+// @Description: Pad the right side of the string until it takes width terminal columns.
+// @receiver s
+// @param width
+// @param pad
+// @return Stringable
+func (s Stringable) PadRightWidth(width int, pad string) Stringable {
+	return Of(PadRightWidth(s.value, width, pad))
+}
+
+// PadBothWidth
+// @Description: Pad both sides of the string until it takes width terminal columns.
+// @receiver s
+// @param width
+// @param pad
+// @return Stringable
+func (s Stringable) PadBothWidth(width int, pad string) Stringable {
+	return Of(PadBothWidth(s.value, width, pad))
+}
+
+// TruncateWidth
+// @Description: Cut the string to at most width terminal columns including the ellipsis.
+// @receiver s
+// @param width
+// @param ellipsis
+// @return Stringable
+func (s Stringable) TruncateWidth(width int, ellipsis string) Stringable {
+	return Of(TruncateWidth(s.value, width, ellipsis))
+}
+
+// Match
+// @Description: Get the first capture group of the first match.
+// @receiver s
+// @param pattern
+// @return Stringable
+func (s Stringable) Match(pattern string) Stringable {
+	return Of(Match(pattern, s.value))
+}
+
+// MatchAll
+// @Description: Get the first capture group of every match.
+// @receiver s
+// @param pattern
+// @return []string
+func (s Stringable) MatchAll(pattern string) []string {
+	return MatchAll(pattern, s.value)
+}
+
+// IsMatch
+// @Description: Determine if the string matches any of the regular expressions.
+// @receiver s
+// @param patterns
+// @return bool
+func (s Stringable) IsMatch(patterns ...string) bool {
+	return IsMatch(patterns, s.value)
+}
+
+// ReplaceMatches
+// @Description: Replace the matches of a pattern with the result of the callback.
+// @receiver s
+// @param pattern
+// @param callback
+// @param limit
+// @return Stringable
+func (s Stringable) ReplaceMatches(pattern string, callback func(groups []string) string, limit int) Stringable {
+	return Of(ReplaceMatches(pattern, callback, s.value, limit))
+}
+
+// Interpolate
+// @Description: Replace the :name and {name} placeholders of the string with values.
+// @receiver s
+// @param values
+// @return Stringable
+func (s Stringable) Interpolate(values map[string]any) Stringable {
+	return Of(Interpolate(s.value, values))
+}
+
+// StripTags
+// @Description: Remove the HTML tags of the string, see StripTags.
+// @receiver s
+// @param allowedTags
+// @return Stringable
+func (s Stringable) StripTags(allowedTags ...string) Stringable {
+	return Of(StripTags(s.value, allowedTags))
+}
+
+// Sanitize
+// @Description: Clean the string as untrusted HTML with an allow-list, see Sanitize.
+// @receiver s
+// @param options
+// @return Stringable
+func (s Stringable) Sanitize(options SanitizeOptions) Stringable {
+	return Of(Sanitize(s.value, options))
+}
+
+// Unescape
+// @Description: Decode the HTML entities of the string.
+// @receiver s
+// @return Stringable
+func (s Stringable) Unescape() Stringable {
+	return Of(Unescape(s.value))
+}
+
+// ToPlainText
+// @Description: Convert the string from HTML to readable text, see ToPlainText.
+// @receiver s
+// @return Stringable
+func (s Stringable) ToPlainText() Stringable {
+	return Of(ToPlainText(s.value))
+}
+
+// Markdown
+// @Description: Render the string from Markdown as HTML, see Markdown.
+// @receiver s
+// @param options
+// @return Stringable
+func (s Stringable) Markdown(options MarkdownOptions) Stringable {
+	return Of(Markdown(s.value, options))
+}
+
+// InlineMarkdown
+// @Description: Render the string from inline Markdown as HTML, see InlineMarkdown.
+// @receiver s
+// @param options
+// @return Stringable
+func (s Stringable) InlineMarkdown(options MarkdownOptions) Stringable {
+	return Of(InlineMarkdown(s.value, options))
+}
+
+// MarkdownToPlainText
+// @Description: Render the string from Markdown as readable text without markup.
+// @receiver s
+// @return Stringable
+func (s Stringable) MarkdownToPlainText() Stringable {
+	return Of(MarkdownToPlainText(s.value))
+}
+
+// ContainsFold
+// @Description: Determine if the string contains a given substring, ignoring case.
+// @receiver s
+// @param needles
+// @return bool
+func (s Stringable) ContainsFold(needles string) bool {
+	return ContainsFold(s.value, needles)
+}
+
+// StartsWithFold
+// @Description: Determine if the string starts with a given substring, ignoring case.
+// @receiver s
+// @param needles
+// @return bool
+func (s Stringable) StartsWithFold(needles string) bool {
+	return StartsWithFold(s.value, needles)
+}
+
+// EndsWithFold
+// @Description: Determine if the string ends with a given substring, ignoring case.
+// @receiver s
+// @param needles
+// @return bool
+func (s Stringable) EndsWithFold(needles string) bool {
+	return EndsWithFold(s.value, needles)
+}
+
+// AfterFold
+// @Description: Return the remainder of the string after the first occurrence of a given value, ignoring case.
+// @receiver s
+// @param search
+// @return Stringable
+func (s Stringable) AfterFold(search string) Stringable {
+	return Of(AfterFold(s.value, search))
+}
+
+// AfterLastFold
+// @Description: Return the remainder of the string after the last occurrence of a given value, ignoring case.
+// @receiver s
+// @param search
+// @return Stringable
+func (s Stringable) AfterLastFold(search string) Stringable {
+	return Of(AfterLastFold(s.value, search))
+}
+
+// BeforeFold
+// @Description: Get the portion of the string before the first occurrence of a given value, ignoring case.
+// @receiver s
+// @param search
+// @return Stringable
+func (s Stringable) BeforeFold(search string) Stringable {
+	return Of(BeforeFold(s.value, search))
+}
+
+// BeforeLastFold
+// @Description: Get the portion of the string before the last occurrence of a given value, ignoring case.
+// @receiver s
+// @param search
+// @return Stringable
+func (s Stringable) BeforeLastFold(search string) Stringable {
+	return Of(BeforeLastFold(s.value, search))
+}
+
+// ReplaceFold
+// @Description: Replace the given value in the string, ignoring case.
+// @receiver s
+// @param search
+// @param replace
+// @return Stringable
+func (s Stringable) ReplaceFold(search string, replace string) Stringable {
+	return Of(ReplaceFold(search, replace, s.value))
+}
+
+// ReplaceFirstFold
+// @Description: Replace the first occurrence of a given value in the string, ignoring case.
+// @receiver s
+// @param search
+// @param replace
+// @return Stringable
+func (s Stringable) ReplaceFirstFold(search string, replace string) Stringable {
+	return Of(ReplaceFirstFold(search, replace, s.value))
+}
+
+// ReplaceLastFold
+// @Description: Replace the last occurrence of a given value in the string, ignoring case.
+// @receiver s
+// @param search
+// @param replace
+// @return Stringable
+func (s Stringable) ReplaceLastFold(search string, replace string) Stringable {
+	return Of(ReplaceLastFold(search, replace, s.value))
+}
+
+// RemoveFold
+// @Description: Remove any occurrence of the given string, ignoring case.
+// @receiver s
+// @param search
+// @return Stringable
+func (s Stringable) RemoveFold(search string) Stringable {
+	return Of(RemoveFold(search, s.value))
+}
+
+// StartsWithAny
+// @Description: Determine if the string starts with any one of the given substrings.
+// @receiver s
+// @param needles
+// @return bool
+func (s Stringable) StartsWithAny(needles ...string) bool {
+	return StartsWithAny(s.value, needles)
+}
+
+// EndsWithAny
+// @Description: Determine if the string ends with any one of the given substrings.
+// @receiver s
+// @param needles
+// @return bool
+func (s Stringable) EndsWithAny(needles ...string) bool {
+	return EndsWithAny(s.value, needles)
+}
+
+// Strtr
+// @Description: Replace substrings in a single pass, the longest key wins where several match.
+// @receiver s
+// @param replacements
+// @return Stringable
+func (s Stringable) Strtr(replacements map[string]string) Stringable {
+	return Of(Strtr(s.value, replacements))
+}
+
+// Plural
+// @Description: Get the plural form of the last word of the string.
+// @receiver s
+// @param count
+// @return Stringable
+func (s Stringable) Plural(count int) Stringable {
+	return Of(Plural(s.value, count))
+}
+
+// Singular
+// @Description: Get the singular form of the last word of the string.
+// @receiver s
+// @return Stringable
+func (s Stringable) Singular() Stringable {
+	return Of(Singular(s.value))
+}
+
+// PluralStudly
+// @Description: Pluralize the last word of the string and convert it to studly caps case.
+// @receiver s
+// @param count
+// @return Stringable
+func (s Stringable) PluralStudly(count int) Stringable {
+	return Of(PluralStudly(s.value, count))
+}
+
+// Ascii
+// @Description: Transliterate the string to ASCII.
+// @receiver s
+// @param language
+// @return Stringable
+func (s Stringable) Ascii(language string) Stringable {
+	return Of(Ascii(s.value, language))
+}
+
+// Slug
+// @Description: Generate a URL friendly "slug" from the string.
+// @receiver s
+// @param separator
+// @param language
+// @param dictionary
+// @return Stringable
+func (s Stringable) Slug(separator string, language string, dictionary map[string]string) Stringable {
+	return Of(Slug(s.value, separator, language, dictionary))
+}
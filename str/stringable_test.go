@@ -0,0 +1,113 @@
+package str
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func BenchmarkStringable(t *testing.B) {
+	tests := []struct {
+		name string
+		got  func() Stringable
+		want string
+	}{
+		{
+			name: "chain",
+			got: func() Stringable {
+				return Of("models/user-profile").After("/").Snake().Finish("_id")
+			},
+			want: "user_profile_id",
+		}, {
+			name: "chain studly input",
+			got: func() Stringable {
+				return Of("models/UserProfile").After("/").Snake().Finish("_id")
+			},
+			want: "user_profile_id",
+		}, {
+			name: "when true",
+			got: func() Stringable {
+				return Of("tom").When(true, func(s Stringable) Stringable {
+					return s.UcFirst()
+				})
+			},
+			want: "Tom",
+		}, {
+			name: "unless true",
+			got: func() Stringable {
+				return Of("tom").Unless(true, func(s Stringable) Stringable {
+					return s.UcFirst()
+				})
+			},
+			want: "tom",
+		}, {
+			name: "pipe",
+			got: func() Stringable {
+				return Of("golang").Pipe(Reverse).Upper()
+			},
+			want: "GNALOG",
+		}, {
+			name: "strtr",
+			got: func() Stringable {
+				return Of("Hi all").Strtr(map[string]string{"Hi": "Hello", "Hello": "Hi"}).AfterFold("HELLO")
+			},
+			want: "all",
+		}, {
+			name: "append prepend",
+			got: func() Stringable {
+				return Of("b").Append("c", "d").Prepend("a")
+			},
+			want: "abcd",
+		}, {
+			name: "mask pad",
+			got: func() Stringable {
+				return Of("186777123456").Mask("*", 3, 4).PadLeft(14, "#")
+			},
+			want: "##186****23456",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := tt.got().String(); got != tt.want {
+				t.Errorf("Stringable = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkStringableTap(t *testing.B) {
+	t.Run("tap", func(t *testing.B) {
+		seen := ""
+		got := Of("tom").Tap(func(s Stringable) {
+			seen = s.Upper().String()
+		}).String()
+		if got != "tom" || seen != "TOM" {
+			t.Errorf("Tap() = %v %v, want %v %v", got, seen, "tom", "TOM")
+		}
+	})
+}
+
+func BenchmarkStringablePredicate(t *testing.B) {
+	t.Run("predicate", func(t *testing.B) {
+		s := Of("The event will take place")
+		if !s.Is("The*place") || !s.Contains("EVENT", true) || !s.StartsWith("The") || s.EndsWith("The") {
+			t.Errorf("predicates of %v are wrong", s)
+		}
+		if s.Length() != 25 || s.IsEmpty() || !Of("").IsEmpty() {
+			t.Errorf("Length() = %v, want %v", s.Length(), 25)
+		}
+	})
+}
+
+func BenchmarkStringableJSON(t *testing.B) {
+	t.Run("json", func(t *testing.B) {
+		data := map[string]Stringable{"name": Of("tom")}
+		encoded, err := json.Marshal(data)
+		if err != nil || string(encoded) != `{"name":"tom"}` {
+			t.Errorf("MarshalJSON() = %s %v, want %v", encoded, err, `{"name":"tom"}`)
+		}
+		var decoded map[string]Stringable
+		if err = json.Unmarshal(encoded, &decoded); err != nil || decoded["name"].String() != "tom" {
+			t.Errorf("UnmarshalJSON() = %v %v, want %v", decoded, err, "tom")
+		}
+	})
+}
@@ -0,0 +1,226 @@
+package str
+
+import (
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"reflect"
+	"strings"
+)
+
+// TableAlign how a column's cells sit in their width.
+type TableAlign int
+
+const (
+	AlignLeft TableAlign = iota
+	AlignRight
+	AlignCenter
+)
+
+// TableBorder the characters a table is drawn with.
+type TableBorder int
+
+const (
+	BorderASCII TableBorder = iota // +---+ and | lines
+	BorderBox                      // Unicode box drawing lines
+	BorderNone                     // columns separated by two spaces, a dashed line under the headers
+)
+
+// tableGlyphs
+// @Description: the characters of a border style, corners and crossings are listed top, middle, bottom.
+type tableGlyphs struct {
+	horizontal string
+	vertical   string
+	left       [3]string
+	cross      [3]string
+	right      [3]string
+}
+
+// tableBorders the glyphs of each border style with lines.
+var tableBorders = map[TableBorder]tableGlyphs{
+	BorderASCII: {"-", "|", [3]string{"+", "+", "+"}, [3]string{"+", "+", "+"}, [3]string{"+", "+", "+"}},
+	BorderBox:   {"─", "│", [3]string{"┌", "├", "└"}, [3]string{"┬", "┼", "┴"}, [3]string{"┐", "┤", "┘"}},
+}
+
+// TableOptions
+// @Description: how Table renders the rows.
+type TableOptions struct {
+	Headers   []string     // header row, TableOf uses the field names when empty
+	Align     []TableAlign // alignment per column, missing columns are left aligned
+	MaxWidths []int        // widest a column may be, its cells are wrapped, 0 or missing means no limit
+	Border    TableBorder
+}
+
+// Table
+// @Description: Render rows as a text table for terminals, cells are measured by display width so CJK
+// text and emoji line up. Cells may contain line breaks, rows shorter than the others are filled.
+// @param rows
+// @param options
+// @return string
+func Table(rows [][]string, options TableOptions) string {
+	columns := len(options.Headers)
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	if columns == 0 {
+		return ""
+	}
+	// every cell becomes its lines, wrapped to the column's maximum width
+	cells := func(row []string) [][]string {
+		response := make([][]string, columns)
+		for i := range response {
+			value := ""
+			if i < len(row) {
+				value = row[i]
+			}
+			if i < len(options.MaxWidths) && options.MaxWidths[i] > 0 {
+				value = WordWrap(value, options.MaxWidths[i], "\n", true)
+			}
+			response[i] = strings.Split(value, "\n")
+		}
+		return response
+	}
+	var body [][][]string
+	for _, row := range rows {
+		body = append(body, cells(row))
+	}
+	var header [][]string
+	if len(options.Headers) > 0 {
+		header = cells(options.Headers)
+	}
+	widths := make([]int, columns)
+	for _, row := range append([][][]string{header}, body...) {
+		for i, lines := range row {
+			for _, line := range lines {
+				if w := displayWidth(line); w > widths[i] {
+					widths[i] = w
+				}
+			}
+		}
+	}
+	align := func(value string, column int) string {
+		switch {
+		case column >= len(options.Align):
+		case options.Align[column] == AlignRight:
+			return PadLeftWidth(value, widths[column], " ")
+		case options.Align[column] == AlignCenter:
+			return PadBothWidth(value, widths[column], " ")
+		}
+		return PadRightWidth(value, widths[column], " ")
+	}
+	glyphs, lined := tableBorders[options.Border]
+	var builder strings.Builder
+	rule := func(position int) {
+		if !lined {
+			parts := make([]string, columns)
+			for i, width := range widths {
+				parts[i] = strings.Repeat("-", width)
+			}
+			builder.WriteString(strings.Join(parts, "  ") + "\n")
+			return
+		}
+		builder.WriteString(glyphs.left[position])
+		for i, width := range widths {
+			if i > 0 {
+				builder.WriteString(glyphs.cross[position])
+			}
+			builder.WriteString(strings.Repeat(glyphs.horizontal, width+2))
+		}
+		builder.WriteString(glyphs.right[position] + "\n")
+	}
+	writeRow := func(row [][]string) {
+		height := 0
+		for _, lines := range row {
+			if len(lines) > height {
+				height = len(lines)
+			}
+		}
+		for index := 0; index < height; index++ {
+			parts := make([]string, columns)
+			for i, lines := range row {
+				value := ""
+				if index < len(lines) {
+					value = lines[index]
+				}
+				parts[i] = align(value, i)
+			}
+			if lined {
+				builder.WriteString(glyphs.vertical + " " + strings.Join(parts, " "+glyphs.vertical+" ") + " " + glyphs.vertical + "\n")
+			} else {
+				builder.WriteString(strings.TrimRight(strings.Join(parts, "  "), " ") + "\n")
+			}
+		}
+	}
+	if lined {
+		rule(0)
+	}
+	if header != nil {
+		writeRow(header)
+		if lined && len(body) == 0 {
+			rule(2)
+			return builder.String()
+		}
+		rule(1)
+	}
+	for _, row := range body {
+		writeRow(row)
+	}
+	if lined {
+		rule(2)
+	}
+	return builder.String()
+}
+
+// TableOf
+// @Description: Render a slice of structs as a text table, one row per element and one column per
+// exported field. A `table:"Name"` tag renames a column and `table:"-"` leaves the field out.
+// @param rows a slice of structs or of pointers to structs, nil pointers give empty rows
+// @param options headers default to the field names
+// @return string
+func TableOf(rows any, options TableOptions) string {
+	value := reflect.ValueOf(rows)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		panic(exceptions.NewInvalidParamError(fmt.Sprintf("table rows must be a slice of structs:%T", rows)))
+	}
+	elementType := value.Type().Elem()
+	if elementType.Kind() == reflect.Pointer {
+		elementType = elementType.Elem()
+	}
+	if elementType.Kind() != reflect.Struct {
+		panic(exceptions.NewInvalidParamError(fmt.Sprintf("table rows must be a slice of structs:%T", rows)))
+	}
+	var fields []int
+	var names []string
+	for i := 0; i < elementType.NumField(); i++ {
+		field := elementType.Field(i)
+		name := field.Tag.Get("table")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, i)
+		names = append(names, name)
+	}
+	if len(options.Headers) == 0 {
+		options.Headers = names
+	}
+	table := make([][]string, value.Len())
+	for i := range table {
+		element := value.Index(i)
+		if element.Kind() == reflect.Pointer {
+			if element.IsNil() {
+				table[i] = []string{}
+				continue
+			}
+			element = element.Elem()
+		}
+		table[i] = make([]string, len(fields))
+		for j, field := range fields {
+			table[i][j] = fmt.Sprint(element.Field(field).Interface())
+		}
+	}
+	return Table(table, options)
+}
@@ -0,0 +1,95 @@
+package str
+
+import (
+	"testing"
+)
+
+func BenchmarkTable(t *testing.B) {
+	tests := []struct {
+		name    string
+		rows    [][]string
+		options TableOptions
+		want    string
+	}{
+		{
+			name:    "ascii",
+			rows:    [][]string{{"Tom", "3"}, {"张三", "42"}},
+			options: TableOptions{Headers: []string{"Name", "Age"}, Align: []TableAlign{AlignLeft, AlignRight}},
+			want: "+------+-----+\n" +
+				"| Name | Age |\n" +
+				"+------+-----+\n" +
+				"| Tom  |   3 |\n" +
+				"| 张三 |  42 |\n" +
+				"+------+-----+\n",
+		},
+		{
+			name:    "box",
+			rows:    [][]string{{"a", "😀"}, {"bcd"}},
+			options: TableOptions{Align: []TableAlign{AlignCenter}, Border: BorderBox},
+			want: "┌─────┬────┐\n" +
+				"│  a  │ 😀 │\n" +
+				"│ bcd │    │\n" +
+				"└─────┴────┘\n",
+		},
+		{
+			name:    "none",
+			rows:    [][]string{{"go", "fast"}, {"php", ""}},
+			options: TableOptions{Headers: []string{"Lang", "Note"}, Border: BorderNone},
+			want: "Lang  Note\n" +
+				"----  ----\n" +
+				"go    fast\n" +
+				"php\n",
+		},
+		{
+			name:    "wrap",
+			rows:    [][]string{{"1", "the quick brown fox"}},
+			options: TableOptions{Headers: []string{"#", "Text"}, MaxWidths: []int{0, 9}},
+			want: "+---+-----------+\n" +
+				"| # | Text      |\n" +
+				"+---+-----------+\n" +
+				"| 1 | the quick |\n" +
+				"|   | brown fox |\n" +
+				"+---+-----------+\n",
+		},
+		{
+			name:    "headers only",
+			options: TableOptions{Headers: []string{"Empty"}},
+			want:    "+-------+\n| Empty |\n+-------+\n",
+		},
+		{
+			name: "nothing",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Table(tt.rows, tt.options); got != tt.want {
+				t.Errorf("Table() = \n%s, want \n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkTableOf(t *testing.B) {
+	type user struct {
+		Name     string
+		Age      int    `table:"年龄"`
+		Password string `table:"-"`
+		private  string
+	}
+	t.Run("structs", func(t *testing.B) {
+		got := TableOf([]*user{{Name: "Tom", Age: 3, Password: "secret"}, nil}, TableOptions{Border: BorderNone})
+		want := "Name  年龄\n----  ----\nTom   3\n\n"
+		if got != want {
+			t.Errorf("TableOf() = %q, want %q", got, want)
+		}
+	})
+	t.Run("not structs", func(t *testing.B) {
+		defer func() {
+			if err := recover(); err == nil {
+				t.Errorf("TableOf() want panic")
+			}
+		}()
+		TableOf([]int{1}, TableOptions{})
+	})
+}
@@ -0,0 +1,325 @@
+package str
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// identifierFactory
+// @Description: replaceable generator so tests can control the identifiers handed out.
+type identifierFactory struct {
+	mutex   sync.Mutex
+	factory func() string
+}
+
+// next
+// @Description: Get the next identifier from the custom factory, or from the given generator.
+// @receiver f
+// @param generate
+// @return string
+func (f *identifierFactory) next(generate func() string) string {
+	f.mutex.Lock()
+	factory := f.factory
+	f.mutex.Unlock()
+	if factory != nil {
+		return factory()
+	}
+	return generate()
+}
+
+// set
+// @Description: Replace the custom factory, nil restores normal generation.
+// @receiver f
+// @param factory
+func (f *identifierFactory) set(factory func() string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.factory = factory
+}
+
+// sequence
+// @Description: Build a function handing out the values in order, ok is false once they are used up.
+// @param values
+// @return func() (value string, ok bool)
+func sequence(values []string) func() (value string, ok bool) {
+	mutex := sync.Mutex{}
+	index := 0
+	return func() (string, bool) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if index < len(values) {
+			index++
+			return values[index-1], true
+		}
+		return "", false
+	}
+}
+
+// sequenceFactory
+// @Description: Build a factory returning the values in order, then falling back to whenMissing.
+// @param values
+// @param whenMissing
+// @return func() string
+func sequenceFactory(values []string, whenMissing func() string) func() string {
+	next := sequence(values)
+	return func() string {
+		if value, ok := next(); ok {
+			return value
+		}
+		return whenMissing()
+	}
+}
+
+var (
+	uuidFactory = &identifierFactory{}
+	ulidFactory = &identifierFactory{}
+
+	uuidV7Mutex sync.Mutex
+	uuidV7Last  uint64 // last unix millisecond << 12 | sub-millisecond fraction handed out
+
+	uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	ulidPattern = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+)
+
+// crockfordAlphabet the Crockford base32 alphabet used by ULIDs.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// randomBytes
+// @Description: Read cryptographically secure random bytes.
+// @param length
+// @return []byte
+func randomBytes(length int) []byte {
+	response := make([]byte, length)
+	if _, e := rand.Read(response); e != nil {
+		panic(e)
+	}
+	return response
+}
+
+// formatUUID
+// @Description: Format 16 bytes as a canonical lower case UUID string.
+// @param b
+// @return string
+func formatUUID(b []byte) string {
+	encoded := hex.EncodeToString(b)
+	return encoded[0:8] + "-" + encoded[8:12] + "-" + encoded[12:16] + "-" + encoded[16:20] + "-" + encoded[20:32]
+}
+
+// newUUIDv4
+// @Description: Generate a random (version 4) UUID.
+// @return string
+func newUUIDv4() string {
+	b := randomBytes(16)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return formatUUID(b)
+}
+
+// newUUIDv7
+// @Description: Generate a time-ordered (version 7) UUID, strictly increasing within the process.
+// @return string
+func newUUIDv7() string {
+	now := time.Now()
+	stamp := uint64(now.UnixMilli())<<12 | uint64(now.Nanosecond()%1e6)*4096/1e6
+	uuidV7Mutex.Lock()
+	if stamp <= uuidV7Last {
+		stamp = uuidV7Last + 1
+	}
+	uuidV7Last = stamp
+	uuidV7Mutex.Unlock()
+	b := randomBytes(16)
+	milli, fraction := stamp>>12, stamp&0x0fff
+	for i := 0; i < 6; i++ {
+		b[i] = byte(milli >> (40 - 8*i))
+	}
+	b[6] = 0x70 | byte(fraction>>8)
+	b[7] = byte(fraction)
+	b[8] = b[8]&0x3f | 0x80
+	return formatUUID(b)
+}
+
+// newULID
+// @Description: Generate a ULID, a millisecond timestamp followed by 80 random bits in Crockford base32.
+// @return string
+func newULID() string {
+	b := append(make([]byte, 6, 16), randomBytes(10)...)
+	milli := uint64(time.Now().UnixMilli())
+	for i := 0; i < 6; i++ {
+		b[i] = byte(milli >> (40 - 8*i))
+	}
+	// 128 bits are written as 26 characters of 5 bits, the first character holds only 3 bits
+	response := make([]byte, 26)
+	var buffer uint64
+	bits := 2
+	index := 0
+	for _, item := range b {
+		buffer = buffer<<8 | uint64(item)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			response[index] = crockfordAlphabet[(buffer>>bits)&0x1f]
+			index++
+		}
+	}
+	return string(response)
+}
+
+// UUID
+// @Description: Generate a random (version 4) UUID.
+// @return string
+func UUID() string {
+	return uuidFactory.next(newUUIDv4)
+}
+
+// UUIDv7
+// @Description: Generate a time-ordered (version 7) UUID, later values sort after earlier ones.
+// @return string
+func UUIDv7() string {
+	return uuidFactory.next(newUUIDv7)
+}
+
+// OrderedUUID
+// @Description: Generate a time-ordered UUID, an alias of UUIDv7.
+// @return string
+func OrderedUUID() string {
+	return UUIDv7()
+}
+
+// ULID
+// @Description: Generate a ULID.
+// @return string
+func ULID() string {
+	return ulidFactory.next(newULID)
+}
+
+// IsUUID
+// @Description: Determine if a given value is a valid UUID.
+// @param value
+// @param version the required version, 0 accepts any version
+// @return bool
+func IsUUID(value string, version int) bool {
+	if !uuidPattern.MatchString(value) {
+		return false
+	}
+	if version == 0 {
+		return true
+	}
+	return fmt.Sprintf("%x", version) == strings.ToLower(value[14:15])
+}
+
+// IsULID
+// @Description: Determine if a given value is a valid ULID.
+// @param value
+// @return bool
+func IsULID(value string) bool {
+	return ulidPattern.MatchString(value)
+}
+
+// UUIDTime
+// @Description: Get the creation time stored in a version 7 UUID.
+// @param value
+// @return time.Time
+// @return error
+func UUIDTime(value string) (time.Time, error) {
+	if !IsUUID(value, 7) {
+		return time.Time{}, exceptions.NewInvalidParamError(fmt.Sprintf("not a version 7 uuid:%s", value))
+	}
+	b, _ := hex.DecodeString(strings.ReplaceAll(value, "-", "")[:12])
+	var milli int64
+	for _, item := range b {
+		milli = milli<<8 | int64(item)
+	}
+	return time.UnixMilli(milli), nil
+}
+
+// ULIDTime
+// @Description: Get the creation time stored in a ULID.
+// @param value
+// @return time.Time
+// @return error
+func ULIDTime(value string) (time.Time, error) {
+	if !IsULID(value) {
+		return time.Time{}, exceptions.NewInvalidParamError(fmt.Sprintf("not a ulid:%s", value))
+	}
+	var milli int64
+	for _, c := range strings.ToUpper(value[:10]) {
+		milli = milli<<5 | int64(strings.IndexRune(crockfordAlphabet, c))
+	}
+	return time.UnixMilli(milli), nil
+}
+
+// CreateUUIDsUsing
+// @Description: Set the callback used by UUID, UUIDv7 and OrderedUUID, nil restores normal generation.
+// @param factory
+func CreateUUIDsUsing(factory func() string) {
+	uuidFactory.set(factory)
+}
+
+// CreateUUIDsUsingSequence
+// @Description: Return the given UUIDs in order, then values from whenMissing or newly generated ones.
+// @param values
+// @param whenMissing
+func CreateUUIDsUsingSequence(values []string, whenMissing func() string) {
+	if whenMissing == nil {
+		whenMissing = newUUIDv4
+	}
+	uuidFactory.set(sequenceFactory(values, whenMissing))
+}
+
+// FreezeUUIDs
+// @Description: Always return the same UUID, the frozen value is returned.
+// @return string
+func FreezeUUIDs() string {
+	value := newUUIDv4()
+	CreateUUIDsUsing(func() string {
+		return value
+	})
+	return value
+}
+
+// CreateUUIDsNormally
+// @Description: Restore normal UUID generation.
+func CreateUUIDsNormally() {
+	uuidFactory.set(nil)
+}
+
+// CreateULIDsUsing
+// @Description: Set the callback used by ULID, nil restores normal generation.
+// @param factory
+func CreateULIDsUsing(factory func() string) {
+	ulidFactory.set(factory)
+}
+
+// CreateULIDsUsingSequence
+// @Description: Return the given ULIDs in order, then values from whenMissing or newly generated ones.
+// @param values
+// @param whenMissing
+func CreateULIDsUsingSequence(values []string, whenMissing func() string) {
+	if whenMissing == nil {
+		whenMissing = newULID
+	}
+	ulidFactory.set(sequenceFactory(values, whenMissing))
+}
+
+// FreezeULIDs
+// @Description: Always return the same ULID, the frozen value is returned.
+// @return string
+func FreezeULIDs() string {
+	value := newULID()
+	CreateULIDsUsing(func() string {
+		return value
+	})
+	return value
+}
+
+// CreateULIDsNormally
+// @Description: Restore normal ULID generation.
+func CreateULIDsNormally() {
+	ulidFactory.set(nil)
+}
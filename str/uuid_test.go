@@ -0,0 +1,138 @@
+package str
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+func BenchmarkUUID(t *testing.B) {
+	tests := []struct {
+		name     string
+		generate func() string
+		version  int
+	}{
+		{name: "v4", generate: UUID, version: 4},
+		{name: "v7", generate: UUIDv7, version: 7},
+		{name: "ordered", generate: OrderedUUID, version: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			got := tt.generate()
+			if !IsUUID(got, tt.version) || !IsUUID(got, 0) || IsUUID(got, tt.version+1) {
+				t.Errorf("IsUUID(%v, %v) = false, want true", got, tt.version)
+			}
+			if got == tt.generate() {
+				t.Errorf("%s() returned the same value twice", tt.name)
+			}
+		})
+	}
+}
+
+func BenchmarkUUIDv7Order(t *testing.B) {
+	t.Run("ordered", func(t *testing.B) {
+		values := make([]string, 1000)
+		for i := range values {
+			values[i] = UUIDv7()
+		}
+		if !sort.StringsAreSorted(values) {
+			t.Errorf("UUIDv7() values are not increasing")
+		}
+		stamp, err := UUIDTime(values[0])
+		if err != nil || time.Since(stamp) > time.Minute || time.Since(stamp) < -time.Second {
+			t.Errorf("UUIDTime() = %v %v, want about now", stamp, err)
+		}
+		if _, err = UUIDTime(UUID()); err == nil {
+			t.Errorf("UUIDTime() of a v4 uuid want error")
+		}
+	})
+}
+
+func BenchmarkIsUUID(t *testing.B) {
+	tests := []struct {
+		value   string
+		version int
+		want    bool
+	}{
+		{value: "a0a2a2d2-0b87-4a18-83f2-2529882be2de", version: 0, want: true},
+		{value: "a0a2a2d2-0b87-4a18-83f2-2529882be2de", version: 4, want: true},
+		{value: "A0A2A2D2-0B87-4A18-83F2-2529882BE2DE", version: 4, want: true},
+		{value: "a0a2a2d2-0b87-4a18-83f2-2529882be2de", version: 7, want: false},
+		{value: "0190b8f6-3c4e-7a1b-9c2d-1e2f3a4b5c6d", version: 7, want: true},
+		{value: "a0a2a2d20b874a1883f22529882be2de", version: 0, want: false},
+		{value: "g0a2a2d2-0b87-4a18-83f2-2529882be2de", version: 0, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.B) {
+			if got := IsUUID(tt.value, tt.version); got != tt.want {
+				t.Errorf("IsUUID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkULID(t *testing.B) {
+	t.Run("ulid", func(t *testing.B) {
+		before := time.Now().Add(-time.Millisecond)
+		got := ULID()
+		if !IsULID(got) || got == ULID() {
+			t.Errorf("ULID() = %v is not a fresh valid ulid", got)
+		}
+		stamp, err := ULIDTime(got)
+		if err != nil || stamp.Before(before) || stamp.After(time.Now()) {
+			t.Errorf("ULIDTime() = %v %v, want about now", stamp, err)
+		}
+	})
+	t.Run("known", func(t *testing.B) {
+		stamp, err := ULIDTime("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		if err != nil || stamp.UnixMilli() != 1469922850259 {
+			t.Errorf("ULIDTime() = %v %v, want %v", stamp.UnixMilli(), err, 1469922850259)
+		}
+		if IsULID("81ARZ3NDEKTSV4RRFFQ69G5FAV") || IsULID("01ARZ3NDEKTSV4RRFFQ69G5FA") || IsULID("01ARZ3NDEKTSV4RRFFQ69G5FAU") {
+			t.Errorf("IsULID() accepted an invalid ulid")
+		}
+	})
+}
+
+func BenchmarkCreateUUIDsUsing(t *testing.B) {
+	t.Run("freeze", func(t *testing.B) {
+		defer CreateUUIDsNormally()
+		frozen := FreezeUUIDs()
+		if UUID() != frozen || OrderedUUID() != frozen {
+			t.Errorf("UUID() is not frozen to %v", frozen)
+		}
+	})
+	t.Run("sequence", func(t *testing.B) {
+		defer CreateUUIDsNormally()
+		CreateUUIDsUsingSequence([]string{"first", "second"}, func() string {
+			return "missing"
+		})
+		got := []string{UUID(), UUID(), UUID()}
+		if got[0] != "first" || got[1] != "second" || got[2] != "missing" {
+			t.Errorf("UUID() = %v, want [first second missing]", got)
+		}
+	})
+	t.Run("ulid sequence", func(t *testing.B) {
+		defer CreateULIDsNormally()
+		CreateULIDsUsingSequence([]string{"first"}, nil)
+		if got := ULID(); got != "first" {
+			t.Errorf("ULID() = %v, want first", got)
+		}
+		if got := ULID(); !IsULID(got) {
+			t.Errorf("ULID() = %v, want a generated ulid", got)
+		}
+		frozen := FreezeULIDs()
+		if ULID() != frozen {
+			t.Errorf("ULID() is not frozen to %v", frozen)
+		}
+	})
+	t.Run("normally", func(t *testing.B) {
+		CreateUUIDsUsing(func() string {
+			return "custom"
+		})
+		CreateUUIDsNormally()
+		if got := UUID(); !IsUUID(got, 4) {
+			t.Errorf("UUID() = %v, want a generated uuid", got)
+		}
+	})
+}
@@ -0,0 +1,243 @@
+package str
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// eastAsianWideTable runes with East_Asian_Width W or F, plus emoji presented as pictures,
+// which take two columns in a monospaced terminal.
+var eastAsianWideTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115f, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232a, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+		{Lo: 0x23f0, Hi: 0x23f0, Stride: 1},
+		{Lo: 0x23f3, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267f, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26ce, Stride: 1},
+		{Lo: 0x26d4, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+		{Lo: 0x26f5, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26fa, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x2e80, Hi: 0x303e, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33ff, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4dbf, Stride: 1},
+		{Lo: 0x4e00, Hi: 0x9fff, Stride: 1},
+		{Lo: 0xa000, Hi: 0xa4cf, Stride: 1},
+		{Lo: 0xa960, Hi: 0xa97f, Stride: 1},
+		{Lo: 0xac00, Hi: 0xd7a3, Stride: 1},
+		{Lo: 0xf900, Hi: 0xfaff, Stride: 1},
+		{Lo: 0xfe10, Hi: 0xfe19, Stride: 1},
+		{Lo: 0xfe30, Hi: 0xfe6f, Stride: 1},
+		{Lo: 0xff00, Hi: 0xff60, Stride: 1},
+		{Lo: 0xffe0, Hi: 0xffe6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x16fe0, Hi: 0x16fe4, Stride: 1},
+		{Lo: 0x17000, Hi: 0x18aff, Stride: 1},
+		{Lo: 0x1b000, Hi: 0x1b2ff, Stride: 1},
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f200, Hi: 0x1f202, Stride: 1},
+		{Lo: 0x1f210, Hi: 0x1f23b, Stride: 1},
+		{Lo: 0x1f240, Hi: 0x1f248, Stride: 1},
+		{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+		{Lo: 0x1f260, Hi: 0x1f265, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f320, Stride: 1},
+		{Lo: 0x1f32d, Hi: 0x1f335, Stride: 1},
+		{Lo: 0x1f337, Hi: 0x1f37c, Stride: 1},
+		{Lo: 0x1f37e, Hi: 0x1f393, Stride: 1},
+		{Lo: 0x1f3a0, Hi: 0x1f3ca, Stride: 1},
+		{Lo: 0x1f3cf, Hi: 0x1f3d3, Stride: 1},
+		{Lo: 0x1f3e0, Hi: 0x1f3f0, Stride: 1},
+		{Lo: 0x1f3f4, Hi: 0x1f3f4, Stride: 1},
+		{Lo: 0x1f3f8, Hi: 0x1f43e, Stride: 1},
+		{Lo: 0x1f440, Hi: 0x1f440, Stride: 1},
+		{Lo: 0x1f442, Hi: 0x1f4fc, Stride: 1},
+		{Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f54b, Hi: 0x1f54e, Stride: 1},
+		{Lo: 0x1f550, Hi: 0x1f567, Stride: 1},
+		{Lo: 0x1f57a, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f5a4, Hi: 0x1f5a4, Stride: 1},
+		{Lo: 0x1f5fb, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6c5, Stride: 1},
+		{Lo: 0x1f6cc, Hi: 0x1f6cc, Stride: 1},
+		{Lo: 0x1f6d0, Hi: 0x1f6d2, Stride: 1},
+		{Lo: 0x1f6d5, Hi: 0x1f6d7, Stride: 1},
+		{Lo: 0x1f6eb, Hi: 0x1f6ec, Stride: 1},
+		{Lo: 0x1f6f4, Hi: 0x1f6fc, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2fffd, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3fffd, Stride: 1},
+	},
+}
+
+// clusterWidth
+// @Description: Get the number of terminal columns a grapheme cluster takes: 0 for control characters
+// and lone marks, 2 for wide characters, flags and emoji presentation sequences, 1 otherwise.
+// @param cluster
+// @return int
+func clusterWidth(cluster string) int {
+	r, size := utf8.DecodeRuneInString(cluster)
+	switch {
+	case cluster == "":
+		return 0
+	case unicode.In(r, unicode.Cc, unicode.Cf, unicode.Mn, unicode.Me, unicode.Zl, unicode.Zp):
+		return 0
+	case unicode.Is(eastAsianWideTable, r):
+		return 2
+	case graphemePropertyOf(r) == graphemeRegionalIndicator:
+		return 2
+	}
+	// a variation selector 16 asks for the emoji presentation of a text character such as ❤
+	for _, next := range cluster[size:] {
+		if next == 0xfe0f {
+			return 2
+		}
+	}
+	return 1
+}
+
+// displayWidth
+// @Description: Get the number of terminal columns a string takes.
+// @param value
+// @return response
+func displayWidth(value string) (response int) {
+	offsets := graphemeOffsets(value)
+	for i := 1; i < len(offsets); i++ {
+		response += clusterWidth(value[offsets[i-1]:offsets[i]])
+	}
+	return response
+}
+
+// Width
+// @Description: Get the number of terminal columns a string takes, following the Unicode East Asian Width
+// rules: CJK characters and emoji take two columns, combining marks and control characters none.
+// Ambiguous width characters count as one column.
+// @param value
+// @return int
+func Width(value string) int {
+	return displayWidth(value)
+}
+
+// fillWidth
+// @Description: Repeat the pad string to fill exactly width columns, columns a wide pad character does
+// not fit in are filled with spaces.
+// @param pad
+// @param width
+// @return string
+func fillWidth(pad string, width int) string {
+	var builder strings.Builder
+	clusters := Graphemes(pad)
+	filled := 0
+	for i := 0; filled < width && len(clusters) > 0; i++ {
+		w := clusterWidth(clusters[i%len(clusters)])
+		if w == 0 || filled+w > width {
+			break
+		}
+		builder.WriteString(clusters[i%len(clusters)])
+		filled += w
+	}
+	builder.WriteString(strings.Repeat(" ", width-filled))
+	return builder.String()
+}
+
+// PadLeftWidth
+// @Description: Pad the left side of a string until it takes width terminal columns.
+// @param value
+// @param width
+// @param pad
+// @return string
+func PadLeftWidth(value string, width int, pad string) string {
+	if missing := width - displayWidth(value); missing > 0 {
+		return fillWidth(pad, missing) + value
+	}
+	return value
+}
+
+// PadRightWidth
+// @Description: Pad the right side of a string until it takes width terminal columns.
+// @param value
+// @param width
+// @param pad
+// @return string
+func PadRightWidth(value string, width int, pad string) string {
+	if missing := width - displayWidth(value); missing > 0 {
+		return value + fillWidth(pad, missing)
+	}
+	return value
+}
+
+// PadBothWidth
+// @Description: Pad both sides of a string until it takes width terminal columns, the right side gets
+// the odd column.
+// @param value
+// @param width
+// @param pad
+// @return string
+func PadBothWidth(value string, width int, pad string) string {
+	if missing := width - displayWidth(value); missing > 0 {
+		return fillWidth(pad, missing/2) + value + fillWidth(pad, missing-missing/2)
+	}
+	return value
+}
+
+// TruncateWidth
+// @Description: Cut a string so that it takes at most width terminal columns including the ellipsis,
+// which is only appended when the string is cut. Wide characters are never split.
+// @param value
+// @param width
+// @param ellipsis
+// @return string
+func TruncateWidth(value string, width int, ellipsis string) string {
+	if displayWidth(value) <= width {
+		return value
+	}
+	ellipsisWidth := displayWidth(ellipsis)
+	if ellipsisWidth > width {
+		value, ellipsis, ellipsisWidth = ellipsis, "", 0
+	}
+	offsets := graphemeOffsets(value)
+	end, used := 0, 0
+	for i := 1; i < len(offsets); i++ {
+		used += clusterWidth(value[offsets[i-1]:offsets[i]])
+		if used > width-ellipsisWidth {
+			break
+		}
+		end = offsets[i]
+	}
+	return value[:end] + ellipsis
+}
@@ -0,0 +1,85 @@
+package str
+
+import (
+	"testing"
+)
+
+func BenchmarkWidth(t *testing.B) {
+	tests := []struct {
+		name  string
+		value string
+		want  int
+	}{
+		{name: "ascii", value: "golang", want: 6},
+		{name: "cjk", value: "中文ab", want: 6},
+		{name: "fullwidth", value: "ＡＢ", want: 4},
+		{name: "hangul", value: "한국어", want: 6},
+		{name: "combining", value: "café", want: 4},
+		{name: "emoji", value: "👍🏽", want: 2},
+		{name: "zwj sequence", value: "👨‍👩‍👧", want: 2},
+		{name: "flag", value: "🇨🇳", want: 2},
+		{name: "variation selector", value: "❤️", want: 2},
+		{name: "text heart", value: "❤", want: 1},
+		{name: "control", value: "a\tb", want: 2},
+		{name: "empty", value: "", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Width(tt.value); got != tt.want {
+				t.Errorf("Width(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkPadWidth(t *testing.B) {
+	tests := []struct {
+		name string
+		got  func() string
+		want string
+	}{
+		{name: "left", got: func() string { return PadLeftWidth("中文", 6, " ") }, want: "  中文"},
+		{name: "right", got: func() string { return PadRightWidth("中文", 6, "-") }, want: "中文--"},
+		{name: "both", got: func() string { return PadBothWidth("中", 7, "*") }, want: "**中***"},
+		{name: "wide pad", got: func() string { return PadRightWidth("a", 4, "中") }, want: "a中 "},
+		{name: "wider", got: func() string { return PadLeftWidth("中文", 3, " ") }, want: "中文"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := tt.got(); got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkTruncateWidth(t *testing.B) {
+	type args struct {
+		value    string
+		width    int
+		ellipsis string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "fits", args: args{value: "中文", width: 4, ellipsis: "…"}, want: "中文"},
+		{name: "cjk", args: args{value: "中文字符", width: 5, ellipsis: "…"}, want: "中文…"},
+		{name: "never splits wide", args: args{value: "中文字符", width: 4, ellipsis: "…"}, want: "中…"},
+		{name: "ascii", args: args{value: "golang", width: 5, ellipsis: "..."}, want: "go..."},
+		{name: "emoji", args: args{value: "👨‍👩‍👧👨‍👩‍👧", width: 3, ellipsis: "."}, want: "👨‍👩‍👧."},
+		{name: "ellipsis too wide", args: args{value: "golang", width: 2, ellipsis: "..."}, want: ".."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			got := TruncateWidth(tt.args.value, tt.args.width, tt.args.ellipsis)
+			if got != tt.want {
+				t.Errorf("TruncateWidth() = %q, want %q", got, tt.want)
+			}
+			if Width(got) > tt.args.width {
+				t.Errorf("Width(TruncateWidth()) = %v, want at most %v", Width(got), tt.args.width)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package str
+
+import (
+	"fmt"
+	"github.com/melodywen/supports/exceptions"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isIdeograph
+// @Description: Determine if a rune belongs to a script written without spaces, where every character
+// counts as a word.
+// @param r
+// @return bool
+func isIdeograph(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+// isSquishSpace
+// @Description: Determine if a rune is white space, including the invisible fillers Squish removes.
+// @param r
+// @return bool
+func isSquishSpace(r rune) bool {
+	return unicode.IsSpace(r) || r == 0x3164 || r == 0x1160 || r == 0x200b || r == 0xfeff || r == 0x180e
+}
+
+// Words
+// @Description: Limit the number of words in a string, white space separates words.
+// @param value
+// @param words
+// @param end appended when the string is cut
+// @return string
+func Words(value string, words int, end string) string {
+	index := 0
+	for index < len(value) {
+		r, size := utf8.DecodeRuneInString(value[index:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		index += size
+	}
+	for count := 0; index < len(value); count++ {
+		if count == words {
+			return strings.TrimRightFunc(value[:index], unicode.IsSpace) + end
+		}
+		next := strings.IndexFunc(value[index:], unicode.IsSpace)
+		if next < 0 {
+			break
+		}
+		index += next
+		rest := strings.IndexFunc(value[index:], func(r rune) bool { return !unicode.IsSpace(r) })
+		if rest < 0 {
+			break
+		}
+		index += rest
+	}
+	return value
+}
+
+// WordCount
+// @Description: Count the words in a string. Letters, digits and marks form words, an apostrophe or
+// hyphen between letters does not split a word (don't, well-known), and every Chinese or Japanese
+// character counts as a word.
+// @param value
+// @return response
+func WordCount(value string) (response int) {
+	runes := []rune(value)
+	inWord := false
+	for i, r := range runes {
+		switch {
+		case isIdeograph(r):
+			response++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+			if !inWord {
+				response++
+			}
+			inWord = true
+		case inWord && strings.ContainsRune("'’-‐", r) && i+1 < len(runes) && unicode.IsLetter(runes[i+1]) &&
+			!isIdeograph(runes[i+1]):
+			// joins the letters on both sides
+		default:
+			inWord = false
+		}
+	}
+	return response
+}
+
+// WordWrap
+// @Description: Wrap a string to lines of at most width terminal columns, breaking at spaces. Wide
+// characters count as two columns. Line breaks already in the string are kept.
+// @param value
+// @param width
+// @param breakString put between the wrapped lines
+// @param cutLongWords cut words wider than width, otherwise they stay on a line of their own
+// @return string
+func WordWrap(value string, width int, breakString string, cutLongWords bool) string {
+	if width < 1 && cutLongWords {
+		panic(exceptions.NewInvalidParamError(fmt.Sprintf("can not cut words to width %d", width)))
+	}
+	paragraphs := strings.Split(value, "\n")
+	for index, paragraph := range paragraphs {
+		var lines []string
+		line, lineWidth := "", 0
+		for i, word := range strings.Split(paragraph, " ") {
+			wordWidth := displayWidth(word)
+			if i > 0 && lineWidth+1+wordWidth <= width {
+				line, lineWidth = line+" "+word, lineWidth+1+wordWidth
+				continue
+			}
+			if i > 0 {
+				lines = append(lines, line)
+			}
+			line, lineWidth = word, wordWidth
+			for cutLongWords && lineWidth > width {
+				head, headWidth := truncateWidth(line, width)
+				lines = append(lines, head)
+				line, lineWidth = line[len(head):], lineWidth-headWidth
+			}
+		}
+		paragraphs[index] = strings.Join(append(lines, line), breakString)
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
+// truncateWidth
+// @Description: Cut a string to the longest prefix of whole characters that fits in width terminal
+// columns, at least one character is kept so that callers always make progress.
+// @param value
+// @param width
+// @return head
+// @return headWidth
+func truncateWidth(value string, width int) (head string, headWidth int) {
+	offsets := graphemeOffsets(value)
+	end := 0
+	for i := 1; i < len(offsets); i++ {
+		w := clusterWidth(value[offsets[i-1]:offsets[i]])
+		if i > 1 && headWidth+w > width {
+			break
+		}
+		headWidth += w
+		end = offsets[i]
+	}
+	return value[:end], headWidth
+}
+
+// Squish
+// @Description: Remove white space from both ends of a string and collapse runs of white space inside it
+// to a single space, Unicode spaces and invisible fillers included.
+// @param value
+// @return string
+func Squish(value string) string {
+	return strings.Join(strings.FieldsFunc(value, isSquishSpace), " ")
+}
+
+// isWordBoundary
+// @Description: Determine if a grapheme position of a string does not split a word.
+// @param clusters
+// @param position
+// @return bool
+func isWordBoundary(clusters []string, position int) bool {
+	if position <= 0 || position >= len(clusters) {
+		return true
+	}
+	inWord := func(cluster string) bool {
+		r, _ := utf8.DecodeRuneInString(cluster)
+		return (unicode.IsLetter(r) || unicode.IsDigit(r)) && !isIdeograph(r)
+	}
+	return !inWord(clusters[position-1]) || !inWord(clusters[position])
+}
@@ -0,0 +1,131 @@
+package str
+
+import (
+	"testing"
+)
+
+func BenchmarkWords(t *testing.B) {
+	type args struct {
+		value string
+		words int
+		end   string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "cut", args: args{value: "Perfectly balanced, as all things should be.", words: 3, end: " >>>"}, want: "Perfectly balanced, as >>>"},
+		{name: "fits", args: args{value: "Perfectly balanced", words: 3, end: "..."}, want: "Perfectly balanced"},
+		{name: "exact", args: args{value: " Taylor  Otwell ", words: 2, end: "..."}, want: " Taylor  Otwell "},
+		{name: "unicode spaces", args: args{value: "这是　一段　文字", words: 2, end: "..."}, want: "这是　一段..."},
+		{name: "zero", args: args{value: "word", words: 0, end: "..."}, want: "..."},
+		{name: "empty", args: args{value: "", words: 2, end: "..."}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Words(tt.args.value, tt.args.words, tt.args.end); got != tt.want {
+				t.Errorf("Words() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkWordCount(t *testing.B) {
+	tests := []struct {
+		name  string
+		value string
+		want  int
+	}{
+		{name: "punctuation", value: "Hello, world! ¿Qué tal?", want: 4},
+		{name: "apostrophe and hyphen", value: "Don't use well-known words - ever", want: 5},
+		{name: "curly apostrophe", value: "it’s fine", want: 2},
+		{name: "ideographs", value: "我爱Go语言", want: 5},
+		{name: "combining marks", value: "café noir", want: 2},
+		{name: "empty", value: " \t", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := WordCount(tt.value); got != tt.want {
+				t.Errorf("WordCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkWordWrap(t *testing.B) {
+	type args struct {
+		value        string
+		width        int
+		breakString  string
+		cutLongWords bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{name: "spaces", args: args{value: "The quick brown fox", width: 10, breakString: "\n"}, want: "The quick\nbrown fox"},
+		{name: "break string", args: args{value: "A very long woooooooooooord.", width: 8, breakString: "<br>"}, want: "A very<br>long<br>woooooooooooord."},
+		{name: "cut", args: args{value: "A very long woooooooooooord.", width: 8, breakString: "\n", cutLongWords: true}, want: "A very\nlong\nwooooooo\nooooord."},
+		{name: "wide", args: args{value: "中文 字符 测试", width: 9, breakString: "\n"}, want: "中文 字符\n测试"},
+		{name: "wide cut", args: args{value: "中文字符", width: 3, breakString: "\n", cutLongWords: true}, want: "中\n文\n字\n符"},
+		{name: "keeps lines", args: args{value: "ab cd\nef gh", width: 2, breakString: "\n"}, want: "ab\ncd\nef\ngh"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := WordWrap(tt.args.value, tt.args.width, tt.args.breakString, tt.args.cutLongWords); got != tt.want {
+				t.Errorf("WordWrap() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	t.Run("zero width", func(t *testing.B) {
+		defer func() {
+			if err := recover(); err == nil {
+				t.Errorf("WordWrap() want panic")
+			}
+		}()
+		WordWrap("abc", 0, "\n", true)
+	})
+}
+
+func BenchmarkSquish(t *testing.B) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "spaces", value: "  laravel   php  framework  ", want: "laravel php framework"},
+		{name: "tabs and lines", value: "\tlaravel\n\r php\t framework\n", want: "laravel php framework"},
+		{name: "unicode", value: "　laravel  phpㅤframework​", want: "laravel php framework"},
+		{name: "empty", value: "   ", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Squish(tt.value); got != tt.want {
+				t.Errorf("Squish() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkExcerptWordBoundary(t *testing.B) {
+	tests := []struct {
+		name   string
+		text   string
+		phrase string
+		radius string
+		want   string
+	}{
+		{name: "end", text: "The quick brown fox jumps", phrase: "brown", radius: "8", want: "... quick brown fox ..."},
+		{name: "start", text: "alphabet soup", phrase: "soup", radius: "3", want: "... soup"},
+		{name: "keeps phrase", text: "unbelievable", phrase: "lie", radius: "2", want: "...lie..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.B) {
+			if got := Excerpt(tt.text, tt.phrase, map[string]string{"radius": tt.radius}); got != tt.want {
+				t.Errorf("Excerpt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}